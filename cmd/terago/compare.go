@@ -0,0 +1,111 @@
+package main
+
+import (
+	"cmp"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/ekalinin/terago/pkg/core"
+	"github.com/ekalinin/terago/pkg/usecases"
+)
+
+func compareCommand(args []string) {
+	fs := flag.NewFlagSet("compare", flag.ExitOnError)
+
+	inputDir := fs.String("input", "", "Directory path containing YAML files")
+	withDir := fs.String("with", "", "Directory path of another radar to compare with (default: compare two snapshots of -input)")
+	metaPath := fs.String("meta", "", "Path to meta.yaml file of -input (optional)")
+	from := fs.String("from", "", "Date of the left snapshot (default: latest with -with, previous to -to otherwise)")
+	to := fs.String("to", "", "Date of the right snapshot (default: latest)")
+	outputFile := fs.String("output", "compare.html", "Path of the comparison page")
+	templatePath := fs.String("template", "", "Path to radar template file (if empty, uses default template)")
+	includeLinks := fs.Bool("include-links", false, "Include links in radar entries (based on quadrant and technology name)")
+	embedLibs := fs.Bool("embed-libs", false, "Embed JavaScript libraries in HTML instead of loading from CDN")
+	includeDrafts := fs.Bool("include-drafts", false, "Include draft snapshots")
+	lenient := fs.Bool("lenient", false, "Allow unknown keys in meta and technology files")
+
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage:\n")
+		fmt.Fprintf(os.Stderr, "  terago compare -input <directory> [-with <directory>] [options]\n\n")
+		fmt.Fprintf(os.Stderr, "Example:\n")
+		fmt.Fprintf(os.Stderr, "  terago compare -input ./data -from 20240101 -to 20240401\n")
+		fmt.Fprintf(os.Stderr, "  terago compare -input ./teams/data -with ./teams/mobile -output ./public/compare.html\n\n")
+		fmt.Fprintf(os.Stderr, "Options:\n")
+		fs.PrintDefaults()
+	}
+
+	fs.Parse(args)
+
+	if *inputDir == "" {
+		log.Fatalln("Error: Directory path is required (--input)")
+	}
+
+	left := readComparedRadar(*inputDir, *metaPath, *lenient, *includeDrafts)
+	right := left
+	if *withDir != "" {
+		right = readComparedRadar(*withDir, "", *lenient, *includeDrafts)
+	}
+
+	// Two snapshots of one radar: the previous one to the right snapshot by default
+	rightFile, err := usecases.FindSnapshot(right.files, *to)
+	if err != nil {
+		log.Fatalf("Failed to find snapshot in %s: %v", right.dir, err)
+	}
+	leftFile, err := usecases.FindSnapshot(left.files, *from)
+	if *withDir == "" && *from == "" {
+		leftFile, err = previousSnapshot(left.files, rightFile)
+	}
+	if err != nil {
+		log.Fatalf("Failed to find snapshot in %s: %v", left.dir, err)
+	}
+
+	compare := usecases.CompareRadars{
+		OutputFile:   *outputFile,
+		TemplatePath: *templatePath,
+		Left:         usecases.ComparedSnapshot{Name: left.name, File: leftFile, Meta: left.meta},
+		Right:        usecases.ComparedSnapshot{Name: right.name, File: rightFile, Meta: right.meta},
+		IncludeLinks: *includeLinks,
+		EmbedLibs:    *embedLibs,
+	}
+	if err := compare.Do(); err != nil {
+		log.Fatalf("Failed to compare radars: %v", err)
+	}
+	fmt.Printf("Generated: %s\n", *outputFile)
+}
+
+// comparedRadar holds the snapshots and meta of a radar to compare.
+type comparedRadar struct {
+	dir   string
+	name  string
+	meta  core.Meta
+	files []core.TechnologiesFile
+}
+
+// readComparedRadar reads the meta and snapshots of a radar, exiting on errors.
+func readComparedRadar(inputDir, metaPath string, lenient, includeDrafts bool) comparedRadar {
+	meta, err := usecases.ReadMeta(metaPath, inputDir, false, lenient)
+	if err != nil {
+		log.Fatalf("Failed to read meta: %v", err)
+	}
+
+	files, err := usecases.ReadTechnologiesFiles(inputDir, meta, usecases.ReadOptions{Lenient: lenient, IncludeDrafts: includeDrafts})
+	if err != nil {
+		log.Fatalf("Failed to read input directory: %v", err)
+	}
+
+	name := cmp.Or(meta.Title, filepath.Base(inputDir))
+	return comparedRadar{dir: inputDir, name: name, meta: meta, files: files}
+}
+
+// previousSnapshot returns the snapshot before the given one.
+func previousSnapshot(files []core.TechnologiesFile, snapshot core.TechnologiesFile) (core.TechnologiesFile, error) {
+	for i, file := range files {
+		if file.Date == snapshot.Date && i > 0 {
+			return files[i-1], nil
+		}
+	}
+	return core.TechnologiesFile{}, fmt.Errorf("no snapshot before '%s' to compare with", snapshot.Date)
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/ekalinin/terago/pkg/usecases"
+)
+
+func fmtCommand(args []string) {
+	fs := flag.NewFlagSet("fmt", flag.ExitOnError)
+
+	inputDir := fs.String("input", "", "Directory path containing YAML files")
+	metaPath := fs.String("meta", "", "Path to meta.yaml file (optional)")
+	check := fs.Bool("check", false, "Don't rewrite files, exit with code 1 if any file is not formatted")
+	lenient := fs.Bool("lenient", false, "Allow unknown keys in meta and technology files")
+
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage:\n")
+		fmt.Fprintf(os.Stderr, "  terago fmt -input <directory> [options]\n\n")
+		fmt.Fprintf(os.Stderr, "Rewrites technology files in canonical form: entries sorted by quadrant,\n")
+		fmt.Fprintf(os.Stderr, "ring (in meta order) and name, fixed key order, double-quoted values.\n\n")
+		fmt.Fprintf(os.Stderr, "Example:\n")
+		fmt.Fprintf(os.Stderr, "  terago fmt -input ./data\n")
+		fmt.Fprintf(os.Stderr, "  terago fmt -input ./data --check\n\n")
+		fmt.Fprintf(os.Stderr, "Options:\n")
+		fs.PrintDefaults()
+	}
+
+	fs.Parse(args)
+
+	if *inputDir == "" {
+		log.Fatalln("Error: Directory path is required (--input)")
+	}
+
+	meta, err := usecases.ReadMeta(*metaPath, *inputDir, false, *lenient)
+	if err != nil {
+		log.Fatalf("Failed to read meta: %v", err)
+	}
+
+	changed, err := usecases.FormatTechnologiesFiles(*inputDir, meta, *check)
+	if err != nil {
+		log.Fatalf("Failed to format files: %v", err)
+	}
+
+	if *check {
+		for _, file := range changed {
+			fmt.Fprintf(os.Stderr, "Not formatted: %s\n", file)
+		}
+		if len(changed) > 0 {
+			os.Exit(1)
+		}
+		return
+	}
+
+	for _, file := range changed {
+		fmt.Printf("Formatted: %s\n", file)
+	}
+}
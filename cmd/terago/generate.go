@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 
+	"github.com/ekalinin/terago/pkg/core"
 	"github.com/ekalinin/terago/pkg/usecases"
 )
 
@@ -22,12 +23,19 @@ func generateCommand(args []string) {
 	addChanges := fs.Bool("add-changes", false, "add table with description of changed or new technologies")
 	skipFirstRadarChanges := fs.Bool("skip-first-radar-changes", true, "skip changes table for the first (earliest) radar (default: true)")
 	embedLibs := fs.Bool("embed-libs", false, "embed JavaScript libraries in HTML instead of loading from CDN")
+	baseURL := fs.String("base-url", "", "absolute URL of the output directory for links in feed.xml (if empty, links are relative)")
+	lenient := fs.Bool("lenient", false, "allow unknown keys in meta and technology files")
+	includeDrafts := fs.Bool("include-drafts", false, "include draft snapshots in the output")
+	workspacePath := fs.String("workspace", "", "path to workspace file with several radars (default: terago.yaml in the current directory when -input is not given)")
+	git := addGitInputFlags(fs)
 
 	fs.Usage = func() {
 		fmt.Fprintf(os.Stderr, "Usage:\n")
 		fmt.Fprintf(os.Stderr, "  terago generate -input <directory> [options]\n\n")
 		fmt.Fprintf(os.Stderr, "Example:\n")
-		fmt.Fprintf(os.Stderr, "  terago generate -input ./data -output ./public -meta ./data/meta.yaml\n\n")
+		fmt.Fprintf(os.Stderr, "  terago generate -input ./data -output ./public -meta ./data/meta.yaml\n")
+		fmt.Fprintf(os.Stderr, "  terago generate -from-git ./radar.yaml -output ./public\n")
+		fmt.Fprintf(os.Stderr, "  terago generate -workspace ./terago.yaml -output ./public\n\n")
 		fmt.Fprintf(os.Stderr, "Options:\n")
 		fs.PrintDefaults()
 	}
@@ -38,35 +46,67 @@ func generateCommand(args []string) {
 		log.Println("Start, input=", *inputDir, ", output=", *outputDir, ", template=", *templatePath, ", meta=", *metaPath)
 	}
 
+	// Use terago.yaml of the current directory when no input is given
+	if *workspacePath == "" && *inputDir == "" && !git.enabled() {
+		if _, err := os.Stat(core.WorkspaceFileName); err == nil {
+			*workspacePath = core.WorkspaceFileName
+		}
+	}
+	generator := usecases.GenerateRadar{
+		OutputDir:             *outputDir,
+		TemplatePath:          *templatePath,
+		Force:                 *forceRegenerate,
+		Verbose:               *verbose,
+		IncludeLinks:          *includeLinks,
+		AddChanges:            *addChanges,
+		SkipFirstRadarChanges: *skipFirstRadarChanges,
+		EmbedLibs:             *embedLibs,
+		BaseURL:               *baseURL,
+	}
+	// Generate all radars of the workspace
+	if *workspacePath != "" {
+		if *inputDir != "" || *metaPath != "" || git.enabled() {
+			log.Fatalln("Error: --input, --meta and --from-git can't be used with a workspace")
+		}
+		workspace, err := usecases.ReadWorkspace(*workspacePath, *lenient)
+		if err != nil {
+			log.Fatalf("Failed to read workspace: %v", err)
+		}
+		workspaceGenerator := usecases.GenerateWorkspace{
+			Workspace: workspace,
+			OutputDir: *outputDir,
+			Radar:     generator,
+			Options:   usecases.ReadOptions{Lenient: *lenient, IncludeDrafts: *includeDrafts},
+		}
+		if err := workspaceGenerator.Do(); err != nil {
+			log.Fatalf("Failed to generate radars: %v", err)
+		}
+		if *verbose {
+			log.Println("Done.")
+		}
+		return
+	}
+
 	// Read input directory (with yaml files)
+	*inputDir = git.inputDir(*inputDir)
 	if *inputDir == "" {
 		log.Fatalln("Error: Directory path is required (--input)")
 	}
 
 	// Read meta file
-	meta, err := usecases.ReadMeta(*metaPath, *inputDir, *verbose)
+	meta, err := usecases.ReadMeta(*metaPath, *inputDir, *verbose, *lenient)
 	if err != nil {
 		log.Fatalf("Failed to read meta file: %v", err)
 	}
 
-	files, err := usecases.ReadTechnologiesFiles(*inputDir, meta)
+	files, err := git.readFiles(*inputDir, meta, usecases.ReadOptions{Lenient: *lenient, IncludeDrafts: *includeDrafts})
 	if err != nil {
 		log.Fatalf("Failed to read input directory: %v", err)
 	}
 
 	// Generate radar (html files)
-	generator := usecases.GenerateRadar{
-		OutputDir:             *outputDir,
-		TemplatePath:          *templatePath,
-		Files:                 files,
-		Meta:                  meta,
-		Force:                 *forceRegenerate,
-		Verbose:               *verbose,
-		IncludeLinks:          *includeLinks,
-		AddChanges:            *addChanges,
-		SkipFirstRadarChanges: *skipFirstRadarChanges,
-		EmbedLibs:             *embedLibs,
-	}
+	generator.Files = files
+	generator.Meta = meta
 	if err := generator.Do(); err != nil {
 		log.Fatalf("Failed to generate radar: %v", err)
 	}
@@ -75,4 +115,3 @@ func generateCommand(args []string) {
 		log.Println("Done.")
 	}
 }
-
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ekalinin/terago/pkg/core"
+	"github.com/ekalinin/terago/pkg/usecases"
+)
+
+// gitInput holds the flags for reading snapshots from the git history of a single file.
+type gitInput struct {
+	file *string
+	tags *bool
+}
+
+// addGitInputFlags defines the --from-git and --git-tags flags.
+func addGitInputFlags(fs *flag.FlagSet) gitInput {
+	return gitInput{
+		file: fs.String("from-git", "", "Read snapshots from the git history of this technologies file instead of dated files"),
+		tags: fs.Bool("git-tags", false, "With --from-git, use tags instead of commits as snapshots"),
+	}
+}
+
+// enabled checks if snapshots are read from git.
+func (g gitInput) enabled() bool {
+	return *g.file != ""
+}
+
+// inputDir returns the input directory, which defaults to the directory
+// of the --from-git file (where meta.yaml is searched).
+func (g gitInput) inputDir(inputDir string) string {
+	if inputDir == "" && g.enabled() {
+		return filepath.Dir(*g.file)
+	}
+	return inputDir
+}
+
+// readFiles reads snapshots from git or from the files in the input directory.
+// Skipped invalid revisions are printed as warnings.
+func (g gitInput) readFiles(inputDir string, meta core.Meta, opts usecases.ReadOptions) ([]core.TechnologiesFile, error) {
+	if g.enabled() {
+		files, skipped, err := usecases.ReadTechnologiesFromGit(*g.file, meta, *g.tags, opts)
+		for _, w := range skipped {
+			fmt.Fprintln(os.Stderr, w.String())
+		}
+		return files, err
+	}
+	return usecases.ReadTechnologiesFiles(inputDir, meta, opts)
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/ekalinin/terago/pkg/usecases"
+)
+
+func historyCommand(args []string) {
+	fs := flag.NewFlagSet("history", flag.ExitOnError)
+
+	inputDir := fs.String("input", "", "Directory path containing YAML files")
+	metaPath := fs.String("meta", "", "Path to meta.yaml file (optional)")
+	format := fs.String("format", "text", "Output format: text or json")
+	lenient := fs.Bool("lenient", false, "Allow unknown keys in meta and technology files")
+	includeDrafts := fs.Bool("include-drafts", false, "Include draft snapshots")
+	git := addGitInputFlags(fs)
+
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage:\n")
+		fmt.Fprintf(os.Stderr, "  terago history <name> -input <directory> [options]\n\n")
+		fmt.Fprintf(os.Stderr, "Example:\n")
+		fmt.Fprintf(os.Stderr, "  terago history Kafka -input ./data\n")
+		fmt.Fprintf(os.Stderr, "  terago history Kafka -input ./data -format json\n\n")
+		fmt.Fprintf(os.Stderr, "Options:\n")
+		fs.PrintDefaults()
+	}
+
+	name, rest := splitPositional(args, "")
+	fs.Parse(rest)
+	if name == "" && fs.NArg() > 0 {
+		name = fs.Arg(0)
+	}
+
+	if name == "" {
+		log.Fatalln("Error: Technology name is required")
+	}
+	*inputDir = git.inputDir(*inputDir)
+	if *inputDir == "" {
+		log.Fatalln("Error: Directory path is required (--input)")
+	}
+	if *format != "text" && *format != "json" {
+		log.Fatalf("Error: Unknown format '%s' (use text or json)", *format)
+	}
+
+	meta, err := usecases.ReadMeta(*metaPath, *inputDir, false, *lenient)
+	if err != nil {
+		log.Fatalf("Failed to read meta: %v", err)
+	}
+
+	files, err := git.readFiles(*inputDir, meta, usecases.ReadOptions{Lenient: *lenient, IncludeDrafts: *includeDrafts})
+	if err != nil {
+		log.Fatalf("Failed to read input directory: %v", err)
+	}
+
+	history := usecases.GetTechnologyHistory(files, name)
+	if len(history.Entries) == 0 {
+		fmt.Fprintf(os.Stderr, "Technology '%s' not found in %s\n", name, *inputDir)
+		os.Exit(1)
+	}
+
+	if *format == "json" {
+		data, err := json.MarshalIndent(history, "", "  ")
+		if err != nil {
+			log.Fatalf("Failed to encode history: %v", err)
+		}
+		fmt.Println(string(data))
+		return
+	}
+
+	printHistory(history)
+}
+
+// printHistory prints the technology timeline in human-readable form
+func printHistory(history usecases.TechnologyHistory) {
+	fmt.Printf("History of %s:\n\n", history.Name)
+
+	for _, e := range history.Entries {
+		status := ""
+		switch e.Status {
+		case usecases.HistoryStatusNew:
+			status = "NEW"
+		case usecases.HistoryStatusReAdded:
+			status = "RE-ADDED"
+		case usecases.HistoryStatusMoved:
+			status = "MOVED: " + e.PreviousRing + " → " + e.Ring
+		case usecases.HistoryStatusDeleted:
+			status = "DELETED from " + e.Ring
+		}
+		fmt.Printf("  %-12s %-10s %-20s %s\n", e.Date, e.Ring, e.Quadrant, status)
+	}
+
+	fmt.Printf("\nTime in rings:\n\n")
+	for _, p := range history.Rings {
+		to := p.To
+		if p.Current {
+			to = "now (" + p.To + ")"
+		}
+		duration := fmt.Sprintf("%d period(s)", p.Periods)
+		if p.Days > 0 {
+			duration += fmt.Sprintf(", %d day(s)", p.Days)
+		}
+		fmt.Printf("  %-10s %s → %s: %s\n", p.Ring, p.From, to, duration)
+	}
+	fmt.Println()
+}
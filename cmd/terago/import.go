@@ -0,0 +1,75 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/ekalinin/terago/pkg/usecases"
+)
+
+func importCommand(args []string) {
+	if len(args) < 1 {
+		printImportUsage()
+		os.Exit(1)
+	}
+
+	switch args[0] {
+	case "zalando":
+		importZalandoCommand(args[1:])
+	case "help", "-h", "-help", "--help":
+		printImportUsage()
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown import source: %s\n\n", args[0])
+		printImportUsage()
+		os.Exit(1)
+	}
+}
+
+func printImportUsage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "  terago import <source> [options]\n\n")
+	fmt.Fprintf(os.Stderr, "Available Sources:\n")
+	fmt.Fprintf(os.Stderr, "  zalando   Zalando tech-radar config (radar_visualization call or JSON)\n\n")
+	fmt.Fprintf(os.Stderr, "Use \"terago import <source> -h\" for more information about a source.\n")
+}
+
+func importZalandoCommand(args []string) {
+	fs := flag.NewFlagSet("import zalando", flag.ExitOnError)
+
+	inputPath := fs.String("input", "", "Path to JS or JSON file with Zalando tech-radar config")
+	outputDir := fs.String("output", ".", "Directory path for generated meta.yaml and technologies file")
+	date := fs.String("date", "", "Date of the snapshot in YYYYMMDD format")
+	force := fs.Bool("force", false, "Overwrite existing files")
+
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage:\n")
+		fmt.Fprintf(os.Stderr, "  terago import zalando -input <file> -date <YYYYMMDD> [options]\n\n")
+		fmt.Fprintf(os.Stderr, "Example:\n")
+		fmt.Fprintf(os.Stderr, "  terago import zalando -input ./radar.js -date 20240101 -output ./data\n\n")
+		fmt.Fprintf(os.Stderr, "Options:\n")
+		fs.PrintDefaults()
+	}
+
+	fs.Parse(args)
+
+	if *inputPath == "" {
+		log.Fatalln("Error: Input file path is required (--input)")
+	}
+	if *date == "" {
+		log.Fatalln("Error: Snapshot date is required (--date)")
+	}
+
+	importer := usecases.ImportZalando{
+		InputPath: *inputPath,
+		OutputDir: *outputDir,
+		Date:      *date,
+		Force:     *force,
+	}
+	if err := importer.Do(); err != nil {
+		log.Fatalf("Failed to import: %v", err)
+	}
+
+	log.Printf("Imported %s into %s\n", *inputPath, *outputDir)
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ekalinin/terago/pkg/core"
+	"github.com/ekalinin/terago/pkg/usecases"
+)
+
+func initCommand(args []string) {
+	fs := flag.NewFlagSet("init", flag.ExitOnError)
+
+	date := fs.String("date", time.Now().Format("20060102"), "Date of the first snapshot in YYYYMMDD format")
+	withTemplate := fs.Bool("template", false, "Add a sample custom template (template.html)")
+	withCI := fs.Bool("ci", false, "Add a GitHub Actions workflow that runs validate and generate")
+	interactive := fs.Bool("interactive", false, "Ask for title, quadrants and rings")
+
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage:\n")
+		fmt.Fprintf(os.Stderr, "  terago init [directory] [options]\n\n")
+		fmt.Fprintf(os.Stderr, "Example:\n")
+		fmt.Fprintf(os.Stderr, "  terago init ./radar\n")
+		fmt.Fprintf(os.Stderr, "  terago init ./radar --template --ci --interactive\n\n")
+		fmt.Fprintf(os.Stderr, "Options:\n")
+		fs.PrintDefaults()
+	}
+
+	dir, args := splitPositional(args, ".")
+	fs.Parse(args)
+	if fs.NArg() > 0 {
+		dir = fs.Arg(0)
+	}
+
+	initializer := usecases.InitRadar{
+		Dir:          dir,
+		Date:         *date,
+		WithTemplate: *withTemplate,
+		WithCI:       *withCI,
+	}
+
+	if *interactive {
+		reader := bufio.NewReader(os.Stdin)
+		defaultMeta := core.DefaultMeta()
+
+		initializer.Title = prompt(reader, "Title", defaultMeta.Title)
+		initializer.Description = prompt(reader, "Description", defaultMeta.Description)
+
+		var quadrantNames, ringNames []string
+		for _, q := range defaultMeta.Quadrants {
+			quadrantNames = append(quadrantNames, q.Name)
+		}
+		for _, r := range defaultMeta.Rings {
+			ringNames = append(ringNames, r.Name)
+		}
+
+		quadrants := splitList(prompt(reader, "Quadrants (comma-separated)", strings.Join(quadrantNames, ", ")))
+		initializer.Quadrants = usecases.QuadrantsFromNames(quadrants)
+		rings := splitList(prompt(reader, "Rings (comma-separated, innermost first)", strings.Join(ringNames, ", ")))
+		initializer.Rings = usecases.RingsFromNames(rings)
+	}
+
+	if err := initializer.Do(); err != nil {
+		log.Fatalf("Failed to initialize radar: %v", err)
+	}
+
+	log.Printf("Radar initialized in %s\n", dir)
+}
+
+// splitPositional returns the leading positional argument (if any) and the remaining arguments.
+// It allows positional arguments to be placed before flags, e.g. "terago init ./radar --ci".
+func splitPositional(args []string, defaultValue string) (string, []string) {
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		return args[0], args[1:]
+	}
+	return defaultValue, args
+}
+
+// prompt asks the user for a value, returning defaultValue on empty input.
+func prompt(reader *bufio.Reader, question, defaultValue string) string {
+	fmt.Fprintf(os.Stderr, "%s [%s]: ", question, defaultValue)
+	line, err := reader.ReadString('\n')
+	if err != nil && err != io.EOF {
+		log.Fatalf("Failed to read input: %v", err)
+	}
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return defaultValue
+	}
+	return line
+}
+
+// splitList splits a comma-separated list, dropping empty items.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -6,8 +6,8 @@ import (
 	"log"
 	"os"
 	"path/filepath"
-	"strings"
 
+	"github.com/ekalinin/terago/pkg/core"
 	"github.com/ekalinin/terago/pkg/usecases"
 )
 
@@ -16,26 +16,38 @@ func listCommand(args []string) {
 
 	inputDir := fs.String("input", "", "Directory path containing YAML files")
 	outputDir := fs.String("output", "output", "Directory path for HTML output")
+	lenient := fs.Bool("lenient", false, "Allow unknown keys in meta and technology files")
+	git := addGitInputFlags(fs)
 
 	fs.Usage = func() {
 		fmt.Fprintf(os.Stderr, "Usage:\n")
 		fmt.Fprintf(os.Stderr, "  terago list -input <directory> [options]\n\n")
 		fmt.Fprintf(os.Stderr, "Example:\n")
-		fmt.Fprintf(os.Stderr, "  terago list -input ./data -output ./public\n\n")
+		fmt.Fprintf(os.Stderr, "  terago list -input ./data -output ./public\n")
+		fmt.Fprintf(os.Stderr, "  terago list -from-git ./radar.yaml -output ./public\n\n")
 		fmt.Fprintf(os.Stderr, "Options:\n")
 		fs.PrintDefaults()
 	}
 
 	fs.Parse(args)
 
+	*inputDir = git.inputDir(*inputDir)
 	if *inputDir == "" {
 		log.Fatalln("Error: Directory path is required (--input)")
 	}
 
 	// Read meta configuration to get file pattern
-	meta, _ := usecases.ReadMeta("", *inputDir, false)
+	meta, err := usecases.ReadMeta("", *inputDir, false, *lenient)
+	if err != nil {
+		log.Fatalf("Failed to read meta: %v", err)
+	}
+
+	if git.enabled() {
+		listGitSnapshots(*git.file, meta, *git.tags, *outputDir, *lenient)
+		return
+	}
 
-	// Get all valid YAML files
+	// Get all valid technologies files
 	validFiles, err := usecases.GetRadarFiles(*inputDir, meta)
 	if err != nil {
 		log.Fatalf("Failed to read input directory: %v", err)
@@ -50,8 +62,7 @@ func listCommand(args []string) {
 
 	// Check each radar file
 	for _, file := range validFiles {
-		fileName := filepath.Base(file)
-		dateStr := strings.TrimSuffix(fileName, ".yaml")
+		dateStr := usecases.SnapshotDate(file)
 
 		// Check if corresponding HTML file exists
 		htmlFile := filepath.Join(*outputDir, dateStr+".html")
@@ -59,6 +70,14 @@ func listCommand(args []string) {
 
 		fmt.Printf("  %s", dateStr)
 
+		// Drafts are not published by generate
+		if draft, err := usecases.IsDraft(file); err != nil {
+			fmt.Printf(" ? (error reading: %v)\n", err)
+			continue
+		} else if draft {
+			fmt.Printf(" [draft]")
+		}
+
 		if err == nil {
 			// HTML file exists
 			modTime := stat.ModTime()
@@ -73,3 +92,33 @@ func listCommand(args []string) {
 	}
 	fmt.Println()
 }
+
+// listGitSnapshots prints the snapshots read from the git history of a file
+// with their draft and render status.
+func listGitSnapshots(filePath string, meta core.Meta, tags bool, outputDir string, lenient bool) {
+	files, skipped, err := usecases.ReadTechnologiesFromGit(filePath, meta, tags, usecases.ReadOptions{Lenient: lenient, IncludeDrafts: true})
+	if err != nil {
+		log.Fatalf("Failed to read git history: %v", err)
+	}
+	for _, w := range skipped {
+		fmt.Fprintln(os.Stderr, w.String())
+	}
+
+	fmt.Printf("Found %d radar(s) in the git history of %s:\n\n", len(files), filePath)
+	for _, file := range files {
+		fmt.Printf("  %s", file.Date)
+		if file.Draft {
+			fmt.Printf(" [draft]")
+		}
+
+		stat, err := os.Stat(filepath.Join(outputDir, file.Date+".html"))
+		if err == nil {
+			fmt.Printf(" ✓ (rendered: %s)\n", stat.ModTime().Format("2006-01-02 15:04:05"))
+		} else if os.IsNotExist(err) {
+			fmt.Printf(" ✗ (not rendered)\n")
+		} else {
+			fmt.Printf(" ? (error checking: %v)\n", err)
+		}
+	}
+	fmt.Println()
+}
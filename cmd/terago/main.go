@@ -24,6 +24,28 @@ func main() {
 		listCommand(os.Args[2:])
 	case "validate", "val":
 		validateCommand(os.Args[2:])
+	case "import":
+		importCommand(os.Args[2:])
+	case "init":
+		initCommand(os.Args[2:])
+	case "snapshot", "s":
+		snapshotCommand(os.Args[2:])
+	case "tech", "t":
+		techCommand(os.Args[2:])
+	case "history", "hist":
+		historyCommand(os.Args[2:])
+	case "stats":
+		statsCommand(os.Args[2:])
+	case "review":
+		reviewCommand(os.Args[2:])
+	case "schema":
+		schemaCommand(os.Args[2:])
+	case "fmt":
+		fmtCommand(os.Args[2:])
+	case "compare":
+		compareCommand(os.Args[2:])
+	case "serve":
+		serveCommand(os.Args[2:])
 	case "version", "v", "-version", "--version":
 		fmt.Println(core.Version)
 		os.Exit(0)
@@ -47,6 +69,17 @@ func printMainUsage() {
 	fmt.Fprintf(os.Stderr, "  export-template, e  Export embedded template to file for customization\n")
 	fmt.Fprintf(os.Stderr, "  list, l             List available radars and their render status\n")
 	fmt.Fprintf(os.Stderr, "  validate, val       Validate YAML files structure and data\n")
+	fmt.Fprintf(os.Stderr, "  import              Import radar from other formats (e.g. Zalando tech-radar)\n")
+	fmt.Fprintf(os.Stderr, "  init                Create a new radar repository with example data\n")
+	fmt.Fprintf(os.Stderr, "  snapshot, s         Start a new period by copying the latest snapshot\n")
+	fmt.Fprintf(os.Stderr, "  tech, t             Add, move, remove or edit technologies in a snapshot\n")
+	fmt.Fprintf(os.Stderr, "  history, hist       Show a technology's timeline across snapshots\n")
+	fmt.Fprintf(os.Stderr, "  stats               Show radar health metrics and trends\n")
+	fmt.Fprintf(os.Stderr, "  review              List technologies that stayed in a ring longer than allowed\n")
+	fmt.Fprintf(os.Stderr, "  schema              Print JSON Schema for meta or technologies files\n")
+	fmt.Fprintf(os.Stderr, "  fmt                 Rewrite technology files in canonical form\n")
+	fmt.Fprintf(os.Stderr, "  compare             Generate a page comparing two radars or two snapshots side by side\n")
+	fmt.Fprintf(os.Stderr, "  serve               Preview the radar with drafts in the browser\n")
 	fmt.Fprintf(os.Stderr, "  version, v          Show version information\n")
 	fmt.Fprintf(os.Stderr, "  help, h             Show this help message\n\n")
 	fmt.Fprintf(os.Stderr, "Use \"terago <command> -h\" for more information about a command.\n")
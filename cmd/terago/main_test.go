@@ -1,11 +1,16 @@
 package main
 
 import (
+	"encoding/json"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
 
 	"github.com/ekalinin/terago/pkg/core"
 )
@@ -136,6 +141,11 @@ func TestMissingInputDirectory(t *testing.T) {
 	if !strings.Contains(stderr, expectedError) {
 		t.Errorf("Expected error message %q, got %q", expectedError, stderr)
 	}
+
+	_, stderr, exitCode = runCommand(t, binary, "serve")
+	if exitCode == 0 || !strings.Contains(stderr, expectedError) {
+		t.Errorf("Expected serve to require an input directory, got %d %q", exitCode, stderr)
+	}
 }
 
 func TestWithTestData(t *testing.T) {
@@ -618,3 +628,687 @@ rings:
 	}
 }
 
+func TestImportZalando(t *testing.T) {
+	binary := buildBinary(t)
+	tmpDir := t.TempDir()
+
+	inputPath := filepath.Join(tmpDir, "radar.js")
+	content := `radar_visualization({
+  quadrants: [{ name: "Languages" }, { name: "Tools" }],
+  rings: [{ name: "ADOPT" }, { name: "HOLD" }],
+  entries: [{ label: "Go", quadrant: 0, ring: 0 }, { label: "Make", quadrant: 1, ring: 1 }]
+});`
+	if err := os.WriteFile(inputPath, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write input file: %v", err)
+	}
+
+	outputDir := filepath.Join(tmpDir, "data")
+	_, stderr, exitCode := runCommand(t, binary, "import", "zalando",
+		"-input", inputPath,
+		"-date", "20240101",
+		"-output", outputDir)
+
+	if exitCode != 0 {
+		t.Fatalf("Expected exit code 0, got %d. Stderr: %s", exitCode, stderr)
+	}
+
+	// Imported data should pass validation
+	stdout, stderr, exitCode := runCommand(t, binary, "validate", "-input", outputDir)
+	if exitCode != 0 {
+		t.Errorf("Expected imported data to be valid, got exit code %d. Stderr: %s", exitCode, stderr)
+	}
+
+	if !strings.Contains(stdout, "OK: 1 file(s) processed") {
+		t.Errorf("Expected 1 processed file, got: %s", stdout)
+	}
+}
+
+func TestInitInteractive(t *testing.T) {
+	binary := buildBinary(t)
+	dir := filepath.Join(t.TempDir(), "radar")
+
+	cmd := exec.Command(binary, "init", dir, "-interactive", "-date", "20240101")
+	cmd.Stdin = strings.NewReader("Team Radar\n\nTools, Platforms\nUse, Hold\n")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("init failed: %v\n%s", err, output)
+	}
+
+	meta, err := os.ReadFile(filepath.Join(dir, "meta.yaml"))
+	if err != nil {
+		t.Fatalf("Failed to read meta.yaml: %v", err)
+	}
+	if !strings.Contains(string(meta), "Team Radar") || !strings.Contains(string(meta), "Platforms") {
+		t.Errorf("Unexpected meta.yaml content:\n%s", meta)
+	}
+
+	stdout, stderr, exitCode := runCommand(t, binary, "validate", "-input", dir)
+	if exitCode != 0 {
+		t.Errorf("Expected initialized radar to be valid, got exit code %d. Stdout: %s, Stderr: %s", exitCode, stdout, stderr)
+	}
+}
+
+func TestTechCommand(t *testing.T) {
+	binary := buildBinary(t)
+	tmpDir := t.TempDir()
+
+	content := `technologies:
+  - name: "Go"
+    ring: "Trial"
+    quadrant: "Languages"
+    description: "Programming language"
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "20240101.yaml"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write technology file: %v", err)
+	}
+	latest := filepath.Join(tmpDir, "20240201.yaml")
+	if err := os.WriteFile(latest, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write technology file: %v", err)
+	}
+
+	_, stderr, exitCode := runCommand(t, binary, "tech", "move", "Go", "-ring", "Adopt", "-input", tmpDir)
+	if exitCode != 0 {
+		t.Fatalf("Expected exit code 0, got %d. Stderr: %s", exitCode, stderr)
+	}
+
+	// Only the latest snapshot should be changed
+	data, err := os.ReadFile(latest)
+	if err != nil {
+		t.Fatalf("Failed to read technology file: %v", err)
+	}
+	if !strings.Contains(string(data), `ring: "Adopt"`) {
+		t.Errorf("Expected Go to be moved to Adopt, got:\n%s", data)
+	}
+
+	data, err = os.ReadFile(filepath.Join(tmpDir, "20240101.yaml"))
+	if err != nil {
+		t.Fatalf("Failed to read technology file: %v", err)
+	}
+	if string(data) != content {
+		t.Errorf("Expected earlier snapshot to be unchanged, got:\n%s", data)
+	}
+
+	_, _, exitCode = runCommand(t, binary, "tech", "move", "Go", "-ring", "Later", "-input", tmpDir)
+	if exitCode == 0 {
+		t.Error("Expected non-zero exit code for invalid ring")
+	}
+}
+
+func TestReviewAndValidateWarnings(t *testing.T) {
+	binary := buildBinary(t)
+	tmpDir := t.TempDir()
+
+	metaContent := `rings:
+  - name: "Adopt"
+    alias: "adopt"
+  - name: "Assess"
+    alias: "assess"
+    maxAge: "1"
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "meta.yaml"), []byte(metaContent), 0644); err != nil {
+		t.Fatalf("Failed to write meta.yaml: %v", err)
+	}
+
+	techContent := `technologies:
+  - name: "Kafka"
+    ring: "Assess"
+    quadrant: "Platforms"
+    description: "Event streaming"
+`
+	for _, name := range []string{"20240101.yaml", "20240401.yaml"} {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(techContent), 0644); err != nil {
+			t.Fatalf("Failed to write technology file: %v", err)
+		}
+	}
+
+	stdout, _, exitCode := runCommand(t, binary, "review", "-input", tmpDir)
+	if exitCode != 0 {
+		t.Errorf("Expected exit code 0, got %d", exitCode)
+	}
+	if !strings.Contains(stdout, "Kafka") || !strings.Contains(stdout, "2 period(s)") {
+		t.Errorf("Expected Kafka to be due for review, got: %s", stdout)
+	}
+
+	// Drafts are reviewed only with -include-drafts
+	if err := os.WriteFile(filepath.Join(tmpDir, "20240701.draft.yaml"), []byte(techContent), 0644); err != nil {
+		t.Fatalf("Failed to write technology file: %v", err)
+	}
+	stdout, _, _ = runCommand(t, binary, "review", "-input", tmpDir)
+	if !strings.Contains(stdout, "2 period(s)") {
+		t.Errorf("Expected the draft to be left out, got: %s", stdout)
+	}
+	stdout, _, _ = runCommand(t, binary, "review", "-input", tmpDir, "-include-drafts")
+	if !strings.Contains(stdout, "3 period(s)") {
+		t.Errorf("Expected the draft to be reviewed, got: %s", stdout)
+	}
+	if err := os.Remove(filepath.Join(tmpDir, "20240701.draft.yaml")); err != nil {
+		t.Fatalf("Failed to remove draft: %v", err)
+	}
+
+	// Validate reports overdue technologies as warnings only
+	_, stderr, exitCode := runCommand(t, binary, "validate", "-input", tmpDir)
+	if exitCode != 0 {
+		t.Errorf("Expected exit code 0 for warnings, got %d", exitCode)
+	}
+	if !strings.Contains(stderr, "20240401.yaml:3:11: warning: technology 'Kafka'") {
+		t.Errorf("Expected warning for Kafka, got: %s", stderr)
+	}
+
+	_, _, exitCode = runCommand(t, binary, "validate", "-input", tmpDir, "-fail-on-warnings")
+	if exitCode != 2 {
+		t.Errorf("Expected exit code 2 for warnings with -fail-on-warnings, got %d", exitCode)
+	}
+}
+
+func TestValidateReportsAllErrors(t *testing.T) {
+	binary := buildBinary(t)
+	tmpDir := t.TempDir()
+
+	techContent := `technologies:
+  - name: "Go"
+    ring: "Unknown"
+    quadrant: "Languages"
+    description: "Programming language"
+  - name: "Rust"
+    ring: "Adopt"
+    quadrant: "Languages"
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "20240101.yaml"), []byte(techContent), 0644); err != nil {
+		t.Fatalf("Failed to write technology file: %v", err)
+	}
+
+	_, stderr, exitCode := runCommand(t, binary, "validate", "-input", tmpDir)
+	if exitCode != 1 {
+		t.Errorf("Expected exit code 1, got %d", exitCode)
+	}
+	for _, want := range []string{
+		"20240101.yaml:3:11: invalid ring 'Unknown' in technology 'Go'",
+		"20240101.yaml:6:5: technology 'Rust' is missing 'description' field",
+		"2 error(s) in 1 file(s)",
+	} {
+		if !strings.Contains(stderr, want) {
+			t.Errorf("Expected %q in output, got: %s", want, stderr)
+		}
+	}
+}
+
+func TestValidateFormats(t *testing.T) {
+	binary := buildBinary(t)
+
+	stdout, _, exitCode := runCommand(t, binary, "validate", "-input", "../../test/test_input", "-format", "json")
+	if exitCode != 0 {
+		t.Errorf("Expected exit code 0, got %d", exitCode)
+	}
+	if !strings.Contains(stdout, `"errorCount": 0`) {
+		t.Errorf("Expected JSON report, got: %s", stdout)
+	}
+
+	tmpDir := t.TempDir()
+	techContent := `technologies:
+  - name: "Go"
+    ring: "Adpot"
+    quadrant: "Languages"
+    description: "Programming language"
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "20240101.yaml"), []byte(techContent), 0644); err != nil {
+		t.Fatalf("Failed to write technology file: %v", err)
+	}
+
+	stdout, _, exitCode = runCommand(t, binary, "validate", "-input", tmpDir, "-format", "junit")
+	if exitCode != 1 {
+		t.Errorf("Expected exit code 1, got %d", exitCode)
+	}
+	if !strings.Contains(stdout, `<testsuite name="terago validate" tests="1" failures="1">`) {
+		t.Errorf("Expected JUnit report, got: %s", stdout)
+	}
+
+	stdout, _, exitCode = runCommand(t, binary, "validate", "-input", tmpDir, "-format", "sarif")
+	if exitCode != 1 {
+		t.Errorf("Expected exit code 1, got %d", exitCode)
+	}
+	if !strings.Contains(stdout, `"ruleId": "invalid-ring"`) || !strings.Contains(stdout, `"startLine": 3`) {
+		t.Errorf("Expected SARIF report, got: %s", stdout)
+	}
+}
+
+func TestValidateDuplicatesAndTypos(t *testing.T) {
+	binary := buildBinary(t)
+	tmpDir := t.TempDir()
+
+	snapshots := map[string]string{
+		"20240101.yaml": `technologies:
+  - name: "Kubernetes"
+    ring: "Adopt"
+    quadrant: "Platforms"
+    description: "Container orchestration"
+`,
+		"20240201.yaml": `technologies:
+  - name: "Kubernets"
+    ring: "Adopt"
+    quadrant: "Platforms"
+    description: "Container orchestration"
+`,
+	}
+	for name, content := range snapshots {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write technology file: %v", err)
+		}
+	}
+
+	_, stderr, exitCode := runCommand(t, binary, "validate", "-input", tmpDir)
+	if exitCode != 0 {
+		t.Errorf("Expected exit code 0 for warnings, got %d", exitCode)
+	}
+	if !strings.Contains(stderr, "20240201.yaml:2:11: warning: technology 'Kubernets' may be a typo of 'Kubernetes'") {
+		t.Errorf("Expected possible typo warning, got: %s", stderr)
+	}
+
+	duplicate := snapshots["20240201.yaml"] + `  - name: "kubernets"
+    ring: "Trial"
+    quadrant: "Platforms"
+    description: "Again"
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "20240201.yaml"), []byte(duplicate), 0644); err != nil {
+		t.Fatalf("Failed to write technology file: %v", err)
+	}
+
+	_, stderr, exitCode = runCommand(t, binary, "validate", "-input", tmpDir)
+	if exitCode != 1 {
+		t.Errorf("Expected exit code 1 for duplicates, got %d", exitCode)
+	}
+	if !strings.Contains(stderr, "20240201.yaml:6:11: technology 'kubernets' duplicates 'Kubernets' (first defined at line 2)") {
+		t.Errorf("Expected duplicate error, got: %s", stderr)
+	}
+}
+
+func TestValidateLintRules(t *testing.T) {
+	binary := buildBinary(t)
+	tmpDir := t.TempDir()
+
+	metaContent := `lint:
+  rules:
+    hold-replaced-by:
+      severity: error
+    description-length:
+      min: 10
+`
+	techContent := `technologies:
+  - name: "Perl"
+    ring: "Hold"
+    quadrant: "Languages"
+    description: "Scripting"
+`
+	files := map[string]string{"meta.yaml": metaContent, "20240101.yaml": techContent}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	_, stderr, exitCode := runCommand(t, binary, "validate", "-input", tmpDir)
+	if exitCode != 1 {
+		t.Errorf("Expected exit code 1, got %d", exitCode)
+	}
+	for _, want := range []string{
+		"20240101.yaml:3:11: technology 'Perl' in ring 'Hold' has no 'replacedBy'",
+		"20240101.yaml:5:18: warning: description of technology 'Perl' is too short (9 < 10 characters)",
+	} {
+		if !strings.Contains(stderr, want) {
+			t.Errorf("Expected %q in output, got: %s", want, stderr)
+		}
+	}
+
+	// .terago-lint.yaml takes precedence over meta
+	lintContent := `rules:
+  description-length:
+    min: 10
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, ".terago-lint.yaml"), []byte(lintContent), 0644); err != nil {
+		t.Fatalf("Failed to write lint config: %v", err)
+	}
+	_, stderr, exitCode = runCommand(t, binary, "validate", "-input", tmpDir)
+	if exitCode != 0 {
+		t.Errorf("Expected exit code 0 for warnings only, got %d: %s", exitCode, stderr)
+	}
+}
+
+func TestLenientFlag(t *testing.T) {
+	binary := buildBinary(t)
+	tmpDir := t.TempDir()
+
+	techContent := `technologies:
+  - name: "Go"
+    ring: "Adopt"
+    quadrant: "Languages"
+    descripton: "Programming language"
+    description: "Programming language"
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "20240101.yaml"), []byte(techContent), 0644); err != nil {
+		t.Fatalf("Failed to write technology file: %v", err)
+	}
+
+	_, stderr, exitCode := runCommand(t, binary, "validate", "-input", tmpDir)
+	if exitCode != 1 {
+		t.Errorf("Expected exit code 1, got %d", exitCode)
+	}
+	if !strings.Contains(stderr, "20240101.yaml:5:5: unknown field 'descripton' in technology 'Go' (did you mean 'description'?)") {
+		t.Errorf("Expected unknown field error, got: %s", stderr)
+	}
+
+	_, _, exitCode = runCommand(t, binary, "validate", "-input", tmpDir, "-lenient")
+	if exitCode != 0 {
+		t.Errorf("Expected exit code 0 with --lenient, got %d", exitCode)
+	}
+}
+
+func TestGenerateWithBrokenMeta(t *testing.T) {
+	binary := buildBinary(t)
+	tmpDir := t.TempDir()
+
+	metaContent := `title: "Broken Radar"
+quadrants:
+  - name: "Languages"
+    alias: "languages"
+  name: "Tools"
+`
+	techContent := `technologies:
+  - name: "Go"
+    ring: "Adopt"
+    quadrant: "Languages"
+    description: "Programming language"
+`
+	files := map[string]string{"meta.yaml": metaContent, "20240101.yaml": techContent}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	_, stderr, exitCode := runCommand(t, binary, "generate", "-input", tmpDir, "-output", t.TempDir())
+	if exitCode == 0 {
+		t.Error("Expected non-zero exit code for broken meta.yaml")
+	}
+	if !strings.Contains(stderr, "failed to parse meta file") {
+		t.Errorf("Expected parse error, got: %s", stderr)
+	}
+}
+
+func TestSchemaCommand(t *testing.T) {
+	binary := buildBinary(t)
+
+	stdout, _, exitCode := runCommand(t, binary, "schema", "-kind", "technologies", "-meta", "../../test/test_input/meta.yaml")
+	if exitCode != 0 {
+		t.Fatalf("Expected exit code 0, got %d", exitCode)
+	}
+
+	var schema map[string]interface{}
+	if err := json.Unmarshal([]byte(stdout), &schema); err != nil {
+		t.Fatalf("Expected JSON Schema, got: %s", stdout)
+	}
+	if !strings.Contains(stdout, `"Infrastructure"`) {
+		t.Errorf("Expected quadrants from meta in schema, got: %s", stdout)
+	}
+
+	_, _, exitCode = runCommand(t, binary, "schema", "-kind", "radar")
+	if exitCode == 0 {
+		t.Error("Expected non-zero exit code for unknown kind")
+	}
+}
+
+func TestFmtCommand(t *testing.T) {
+	binary := buildBinary(t)
+	tmpDir := t.TempDir()
+
+	techContent := `technologies:
+  - name: Rust
+    ring: Trial
+    quadrant: Languages
+    description: Systems language
+  - name: "Go"
+    ring: "Adopt"
+    quadrant: "Languages"
+    description: "Programming language"
+`
+	filePath := filepath.Join(tmpDir, "20240101.yaml")
+	if err := os.WriteFile(filePath, []byte(techContent), 0644); err != nil {
+		t.Fatalf("Failed to write technology file: %v", err)
+	}
+
+	_, stderr, exitCode := runCommand(t, binary, "fmt", "-input", tmpDir, "-check")
+	if exitCode != 1 {
+		t.Errorf("Expected exit code 1 for unformatted file, got %d", exitCode)
+	}
+	if !strings.Contains(stderr, "Not formatted: "+filePath) {
+		t.Errorf("Expected unformatted file in output, got: %s", stderr)
+	}
+
+	stdout, _, exitCode := runCommand(t, binary, "fmt", "-input", tmpDir)
+	if exitCode != 0 {
+		t.Errorf("Expected exit code 0, got %d", exitCode)
+	}
+	if !strings.Contains(stdout, "Formatted: "+filePath) {
+		t.Errorf("Expected formatted file in output, got: %s", stdout)
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("Failed to read file: %v", err)
+	}
+	if !strings.HasPrefix(string(data), "technologies:\n  - name: \"Go\"\n") {
+		t.Errorf("Expected Go (Adopt) first, got:\n%s", data)
+	}
+
+	_, _, exitCode = runCommand(t, binary, "fmt", "-input", tmpDir, "-check")
+	if exitCode != 0 {
+		t.Errorf("Expected exit code 0 for formatted file, got %d", exitCode)
+	}
+}
+
+func TestDirectoryLayout(t *testing.T) {
+	binary := buildBinary(t)
+	tmpDir := t.TempDir()
+	inputDir := filepath.Join(tmpDir, "input")
+	outputDir := filepath.Join(tmpDir, "output")
+
+	techDir := filepath.Join(inputDir, "20240101", "Languages")
+	if err := os.MkdirAll(techDir, 0755); err != nil {
+		t.Fatalf("Failed to create snapshot directory: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(techDir, "Go.md"), []byte("---\nring: Adopt\n---\nProgramming language\n"), 0644); err != nil {
+		t.Fatalf("Failed to write technology file: %v", err)
+	}
+
+	stdout, stderr, exitCode := runCommand(t, binary, "validate", "-input", inputDir)
+	if exitCode != 0 {
+		t.Fatalf("Expected validate to succeed, got %d: %s%s", exitCode, stdout, stderr)
+	}
+
+	_, stderr, exitCode = runCommand(t, binary, "generate", "-input", inputDir, "-output", outputDir)
+	if exitCode != 0 {
+		t.Fatalf("Expected generate to succeed, got %d: %s", exitCode, stderr)
+	}
+	if _, err := os.Stat(filepath.Join(outputDir, "20240101.html")); err != nil {
+		t.Errorf("Expected rendered snapshot: %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(techDir, "Rust.md"), []byte("---\nring: Never\n---\nSystems language\n"), 0644); err != nil {
+		t.Fatalf("Failed to write technology file: %v", err)
+	}
+	_, stderr, exitCode = runCommand(t, binary, "validate", "-input", inputDir)
+	if exitCode != 1 {
+		t.Errorf("Expected exit code 1 for invalid ring, got %d", exitCode)
+	}
+	if !strings.Contains(stderr, filepath.Join(techDir, "Rust.md")+":2:7:") {
+		t.Errorf("Expected error in Rust.md, got: %s", stderr)
+	}
+}
+
+func TestDrafts(t *testing.T) {
+	binary := buildBinary(t)
+	tmpDir := t.TempDir()
+	inputDir := filepath.Join(tmpDir, "input")
+	outputDir := filepath.Join(tmpDir, "output")
+	if err := os.MkdirAll(inputDir, 0755); err != nil {
+		t.Fatalf("Failed to create input directory: %v", err)
+	}
+
+	content := "technologies:\n  - name: Go\n    ring: Adopt\n    quadrant: Languages\n    description: Programming language\n"
+	if err := os.WriteFile(filepath.Join(inputDir, "20240101.yaml"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(inputDir, "20240201.draft.yaml"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	_, stderr, exitCode := runCommand(t, binary, "generate", "-input", inputDir, "-output", outputDir)
+	if exitCode != 0 {
+		t.Fatalf("Expected generate to succeed, got %d: %s", exitCode, stderr)
+	}
+	if _, err := os.Stat(filepath.Join(outputDir, "20240201.html")); !os.IsNotExist(err) {
+		t.Error("Draft should not be rendered without -include-drafts")
+	}
+
+	stdout, _, exitCode := runCommand(t, binary, "list", "-input", inputDir, "-output", outputDir)
+	if exitCode != 0 {
+		t.Fatalf("Expected list to succeed, got %d", exitCode)
+	}
+	if !strings.Contains(stdout, "20240201 [draft] ✗") || strings.Contains(stdout, "20240101 [draft]") {
+		t.Errorf("Expected draft status in list, got: %s", stdout)
+	}
+
+	_, stderr, exitCode = runCommand(t, binary, "generate", "-input", inputDir, "-output", outputDir, "-include-drafts")
+	if exitCode != 0 {
+		t.Fatalf("Expected generate to succeed, got %d: %s", exitCode, stderr)
+	}
+	if _, err := os.Stat(filepath.Join(outputDir, "20240201.html")); err != nil {
+		t.Errorf("Expected rendered draft with -include-drafts: %v", err)
+	}
+}
+
+func TestGenerateWorkspace(t *testing.T) {
+	binary := buildBinary(t)
+	tmpDir := t.TempDir()
+	outputDir := filepath.Join(tmpDir, "public")
+
+	content := "technologies:\n  - name: Go\n    ring: Adopt\n    quadrant: Languages\n    description: Programming language\n"
+	for _, dir := range []string{"company", "mobile"} {
+		if err := os.MkdirAll(filepath.Join(tmpDir, dir), 0755); err != nil {
+			t.Fatalf("Failed to create input directory: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(tmpDir, dir, "20240101.yaml"), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+	}
+	workspace := filepath.Join(tmpDir, "terago.yaml")
+	if err := os.WriteFile(workspace, []byte("radars:\n  - name: company\n    input: company\n  - name: mobile\n    input: mobile\n    output: teams/mobile\n"), 0644); err != nil {
+		t.Fatalf("Failed to write workspace file: %v", err)
+	}
+
+	_, stderr, exitCode := runCommand(t, binary, "generate", "-workspace", workspace, "-output", outputDir)
+	if exitCode != 0 {
+		t.Fatalf("Expected generate to succeed, got %d: %s", exitCode, stderr)
+	}
+	for _, name := range []string{"index.html", "company/20240101.html", "teams/mobile/20240101.html"} {
+		if _, err := os.Stat(filepath.Join(outputDir, name)); err != nil {
+			t.Errorf("Expected %s: %v", name, err)
+		}
+	}
+
+	_, stderr, exitCode = runCommand(t, binary, "generate", "-workspace", workspace, "-input", tmpDir)
+	if exitCode != 1 || !strings.Contains(stderr, "can't be used with a workspace") {
+		t.Errorf("Expected error for -input with a workspace, got %d: %s", exitCode, stderr)
+	}
+}
+
+func TestCompareCommand(t *testing.T) {
+	binary := buildBinary(t)
+	tmpDir := t.TempDir()
+	inputDir := filepath.Join(tmpDir, "input")
+	if err := os.MkdirAll(inputDir, 0755); err != nil {
+		t.Fatalf("Failed to create input directory: %v", err)
+	}
+
+	snapshots := map[string]string{
+		"20240101.yaml": "technologies:\n  - name: Go\n    ring: Trial\n    quadrant: Languages\n    description: Programming language\n",
+		"20240201.yaml": "technologies:\n  - name: Go\n    ring: Adopt\n    quadrant: Languages\n    description: Programming language\n",
+	}
+	for name, content := range snapshots {
+		if err := os.WriteFile(filepath.Join(inputDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+	}
+
+	outputFile := filepath.Join(tmpDir, "compare.html")
+	stdout, stderr, exitCode := runCommand(t, binary, "compare", "-input", inputDir, "-output", outputFile)
+	if exitCode != 0 {
+		t.Fatalf("Expected compare to succeed, got %d: %s", exitCode, stderr)
+	}
+	if !strings.Contains(stdout, "Generated: "+outputFile) {
+		t.Errorf("Expected generated file in output, got: %s", stdout)
+	}
+	content, err := os.ReadFile(outputFile)
+	if err != nil {
+		t.Fatalf("Failed to read comparison page: %v", err)
+	}
+	if !strings.Contains(string(content), "2024-01-01 vs 2024-02-01") || !strings.Contains(string(content), `<td class="status-trial">Trial</td>`) {
+		t.Errorf("Expected comparison of the last two snapshots, got: %s", content)
+	}
+
+	_, stderr, exitCode = runCommand(t, binary, "compare", "-input", inputDir, "-from", "20230101", "-output", outputFile)
+	if exitCode != 1 || !strings.Contains(stderr, "snapshot '20230101' not found") {
+		t.Errorf("Expected error for unknown snapshot, got %d: %s", exitCode, stderr)
+	}
+}
+
+func TestGenerateFromGit(t *testing.T) {
+	binary := buildBinary(t)
+	repoDir := t.TempDir()
+	outputDir := filepath.Join(t.TempDir(), "output")
+
+	repo, err := git.PlainInit(repoDir, false)
+	if err != nil {
+		t.Fatalf("Failed to init repository: %v", err)
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatalf("Failed to open worktree: %v", err)
+	}
+	radarPath := filepath.Join(repoDir, "radar.yaml")
+	for i, ring := range []string{"Trial", "Adopt"} {
+		content := "technologies:\n  - name: Go\n    ring: " + ring + "\n    quadrant: Languages\n    description: Language\n"
+		if err := os.WriteFile(radarPath, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write radar file: %v", err)
+		}
+		if _, err := worktree.Add("radar.yaml"); err != nil {
+			t.Fatalf("Failed to add file: %v", err)
+		}
+		signature := &object.Signature{Name: "Test", Email: "test@example.com", When: time.Date(2024, 1, i+1, 0, 0, 0, 0, time.UTC)}
+		if _, err := worktree.Commit("Update radar", &git.CommitOptions{Author: signature, Committer: signature}); err != nil {
+			t.Fatalf("Failed to commit: %v", err)
+		}
+	}
+
+	_, stderr, exitCode := runCommand(t, binary, "generate", "-from-git", radarPath, "-output", outputDir)
+	if exitCode != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", exitCode, stderr)
+	}
+	for _, name := range []string{"20240101.html", "20240102.html"} {
+		if _, err := os.Stat(filepath.Join(outputDir, name)); err != nil {
+			t.Errorf("Expected %s to be generated: %v", name, err)
+		}
+	}
+
+	stdout, _, exitCode := runCommand(t, binary, "history", "Go", "-from-git", radarPath)
+	if exitCode != 0 || !strings.Contains(stdout, "20240102") {
+		t.Errorf("Expected history from git, got %d: %s", exitCode, stdout)
+	}
+
+	stdout, _, exitCode = runCommand(t, binary, "list", "-from-git", radarPath, "-output", outputDir)
+	if exitCode != 0 || !strings.Contains(stdout, "Found 2 radar(s)") || !strings.Contains(stdout, "20240102 ✓") {
+		t.Errorf("Expected snapshots from git, got %d: %s", exitCode, stdout)
+	}
+
+	stdout, stderr, exitCode = runCommand(t, binary, "validate", "-from-git", radarPath)
+	if exitCode != 0 || !strings.Contains(stdout, "OK: 1 file(s) processed") {
+		t.Errorf("Expected valid radar file, got %d: %s%s", exitCode, stdout, stderr)
+	}
+}
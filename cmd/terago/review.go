@@ -0,0 +1,65 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/ekalinin/terago/pkg/usecases"
+)
+
+func reviewCommand(args []string) {
+	fs := flag.NewFlagSet("review", flag.ExitOnError)
+
+	inputDir := fs.String("input", "", "Directory path containing YAML files")
+	metaPath := fs.String("meta", "", "Path to meta.yaml file (optional)")
+	lenient := fs.Bool("lenient", false, "Allow unknown keys in meta and technology files")
+	includeDrafts := fs.Bool("include-drafts", false, "Include draft snapshots")
+	git := addGitInputFlags(fs)
+
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage:\n")
+		fmt.Fprintf(os.Stderr, "  terago review -input <directory> [options]\n\n")
+		fmt.Fprintf(os.Stderr, "Lists technologies that stayed in a ring longer than the ring's maxAge from meta.\n\n")
+		fmt.Fprintf(os.Stderr, "Example:\n")
+		fmt.Fprintf(os.Stderr, "  terago review -input ./data\n\n")
+		fmt.Fprintf(os.Stderr, "Options:\n")
+		fs.PrintDefaults()
+	}
+
+	fs.Parse(args)
+
+	*inputDir = git.inputDir(*inputDir)
+	if *inputDir == "" {
+		log.Fatalln("Error: Directory path is required (--input)")
+	}
+
+	meta, err := usecases.ReadMeta(*metaPath, *inputDir, false, *lenient)
+	if err != nil {
+		log.Fatalf("Failed to read meta: %v", err)
+	}
+
+	files, err := git.readFiles(*inputDir, meta, usecases.ReadOptions{Lenient: *lenient, IncludeDrafts: *includeDrafts})
+	if err != nil {
+		log.Fatalf("Failed to read input directory: %v", err)
+	}
+
+	overdue, err := usecases.FindOverdueTechnologies(files, meta, time.Now())
+	if err != nil {
+		log.Fatalf("Failed to check technologies: %v", err)
+	}
+
+	if len(overdue) == 0 {
+		fmt.Println("No technologies due for review")
+		return
+	}
+
+	fmt.Printf("Technologies due for review: %d\n\n", len(overdue))
+	for _, o := range overdue {
+		fmt.Printf("  %-20s %-10s %-20s since %s (%d period(s), max age %s)\n",
+			o.Name, o.Ring, o.Quadrant, o.Since, o.Periods, o.MaxAge)
+	}
+	fmt.Println()
+}
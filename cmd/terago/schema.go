@@ -0,0 +1,52 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/ekalinin/terago/pkg/core"
+	"github.com/ekalinin/terago/pkg/usecases"
+)
+
+func schemaCommand(args []string) {
+	fs := flag.NewFlagSet("schema", flag.ExitOnError)
+
+	kind := fs.String("kind", usecases.SchemaKindTechnologies, "Schema kind: meta or technologies")
+	metaPath := fs.String("meta", "", "Path to meta.yaml file to restrict rings and quadrants (optional)")
+
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage:\n")
+		fmt.Fprintf(os.Stderr, "  terago schema [options]\n\n")
+		fmt.Fprintf(os.Stderr, "Prints a JSON Schema for meta or technologies files.\n\n")
+		fmt.Fprintf(os.Stderr, "Example:\n")
+		fmt.Fprintf(os.Stderr, "  terago schema -kind meta > meta.schema.json\n")
+		fmt.Fprintf(os.Stderr, "  terago schema -kind technologies -meta ./data/meta.yaml > technologies.schema.json\n\n")
+		fmt.Fprintf(os.Stderr, "Options:\n")
+		fs.PrintDefaults()
+	}
+
+	fs.Parse(args)
+
+	var meta *core.Meta
+	if *metaPath != "" {
+		m, err := usecases.ReadMeta(*metaPath, "", false, false)
+		if err != nil {
+			log.Fatalf("Failed to read meta: %v", err)
+		}
+		meta = &m
+	}
+
+	schema, err := usecases.JSONSchema(*kind, meta)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to encode schema: %v", err)
+	}
+	fmt.Println(string(data))
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ekalinin/terago/pkg/usecases"
+)
+
+func serveCommand(args []string) {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+
+	inputDir := fs.String("input", "", "Directory path containing YAML files")
+	metaPath := fs.String("meta", "", "Path to meta.yaml file (optional)")
+	templatePath := fs.String("template", "", "Path to template file (if empty, uses default template)")
+	addr := fs.String("addr", "localhost:8080", "Address to listen on")
+	lenient := fs.Bool("lenient", false, "Allow unknown keys in meta and technology files")
+	verbose := fs.Bool("verbose", false, "Enable verbose logging")
+
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage:\n")
+		fmt.Fprintf(os.Stderr, "  terago serve -input <directory> [options]\n\n")
+		fmt.Fprintf(os.Stderr, "Serves the radar with draft snapshots for preview, regenerated when the input files change.\n\n")
+		fmt.Fprintf(os.Stderr, "Example:\n")
+		fmt.Fprintf(os.Stderr, "  terago serve -input ./data -addr localhost:8080\n\n")
+		fmt.Fprintf(os.Stderr, "Options:\n")
+		fs.PrintDefaults()
+	}
+
+	fs.Parse(args)
+
+	if *inputDir == "" {
+		log.Fatalln("Error: Directory path is required (--input)")
+	}
+
+	outputDir, err := os.MkdirTemp("", "terago-serve-")
+	if err != nil {
+		log.Fatalf("Failed to create output directory: %v", err)
+	}
+
+	server := &usecases.ServeRadar{
+		InputDir:     *inputDir,
+		MetaPath:     *metaPath,
+		TemplatePath: *templatePath,
+		OutputDir:    outputDir,
+		Lenient:      *lenient,
+		Verbose:      *verbose,
+	}
+	httpServer := &http.Server{Addr: *addr, Handler: server.Handler()}
+
+	// Stop on Ctrl+C or SIGTERM, so the temporary directory is removed
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		httpServer.Shutdown(context.Background())
+	}()
+
+	fmt.Printf("Serving %s on http://%s\n", *inputDir, *addr)
+	err = httpServer.ListenAndServe()
+	os.RemoveAll(outputDir)
+	if !errors.Is(err, http.ErrServerClosed) {
+		log.Fatalf("Failed to serve radar: %v", err)
+	}
+}
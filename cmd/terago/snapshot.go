@@ -0,0 +1,55 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/ekalinin/terago/pkg/usecases"
+)
+
+func snapshotCommand(args []string) {
+	fs := flag.NewFlagSet("snapshot", flag.ExitOnError)
+
+	inputDir := fs.String("input", "", "Directory path containing YAML files")
+	metaPath := fs.String("meta", "", "Path to meta.yaml file (optional)")
+	date := fs.String("date", time.Now().Format("20060102"), "Date of the new snapshot (file name without extension)")
+	persistentOnly := fs.Bool("persistent-only", false, "Carry over only entries whose rings have 'persist: true' in meta")
+	lenient := fs.Bool("lenient", false, "Allow unknown keys in meta and technology files")
+
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage:\n")
+		fmt.Fprintf(os.Stderr, "  terago snapshot -input <directory> [options]\n\n")
+		fmt.Fprintf(os.Stderr, "Example:\n")
+		fmt.Fprintf(os.Stderr, "  terago snapshot -input ./data\n")
+		fmt.Fprintf(os.Stderr, "  terago snapshot -input ./data -date 20240401\n\n")
+		fmt.Fprintf(os.Stderr, "Options:\n")
+		fs.PrintDefaults()
+	}
+
+	fs.Parse(args)
+
+	if *inputDir == "" {
+		log.Fatalln("Error: Directory path is required (--input)")
+	}
+
+	meta, err := usecases.ReadMeta(*metaPath, *inputDir, false, *lenient)
+	if err != nil {
+		log.Fatalf("Failed to read meta: %v", err)
+	}
+
+	snapshot := usecases.CreateSnapshot{
+		InputDir:       *inputDir,
+		Meta:           meta,
+		Date:           *date,
+		PersistentOnly: *persistentOnly,
+	}
+	newFile, err := snapshot.Do()
+	if err != nil {
+		log.Fatalf("Failed to create snapshot: %v", err)
+	}
+
+	log.Printf("Snapshot created: %s\n", newFile)
+}
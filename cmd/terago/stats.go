@@ -0,0 +1,165 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/ekalinin/terago/pkg/usecases"
+)
+
+func statsCommand(args []string) {
+	fs := flag.NewFlagSet("stats", flag.ExitOnError)
+
+	inputDir := fs.String("input", "", "Directory path containing YAML files")
+	metaPath := fs.String("meta", "", "Path to meta.yaml file (optional)")
+	format := fs.String("format", "text", "Output format: text, csv or json")
+	staleAfter := fs.Int("stale-after", 4, "Report technologies that haven't changed ring for this number of periods (0 to disable)")
+	lenient := fs.Bool("lenient", false, "Allow unknown keys in meta and technology files")
+	includeDrafts := fs.Bool("include-drafts", false, "Include draft snapshots")
+	git := addGitInputFlags(fs)
+
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage:\n")
+		fmt.Fprintf(os.Stderr, "  terago stats -input <directory> [options]\n\n")
+		fmt.Fprintf(os.Stderr, "Example:\n")
+		fmt.Fprintf(os.Stderr, "  terago stats -input ./data\n")
+		fmt.Fprintf(os.Stderr, "  terago stats -input ./data -format csv > stats.csv\n\n")
+		fmt.Fprintf(os.Stderr, "Options:\n")
+		fs.PrintDefaults()
+	}
+
+	fs.Parse(args)
+
+	*inputDir = git.inputDir(*inputDir)
+	if *inputDir == "" {
+		log.Fatalln("Error: Directory path is required (--input)")
+	}
+	if *format != "text" && *format != "csv" && *format != "json" {
+		log.Fatalf("Error: Unknown format '%s' (use text, csv or json)", *format)
+	}
+
+	meta, err := usecases.ReadMeta(*metaPath, *inputDir, false, *lenient)
+	if err != nil {
+		log.Fatalf("Failed to read meta: %v", err)
+	}
+
+	files, err := git.readFiles(*inputDir, meta, usecases.ReadOptions{Lenient: *lenient, IncludeDrafts: *includeDrafts})
+	if err != nil {
+		log.Fatalf("Failed to read input directory: %v", err)
+	}
+
+	if len(files) == 0 {
+		fmt.Println("No radar files found in", *inputDir)
+		return
+	}
+
+	stats := usecases.GetRadarStats(files, meta, *staleAfter)
+
+	switch *format {
+	case "json":
+		data, err := json.MarshalIndent(stats, "", "  ")
+		if err != nil {
+			log.Fatalf("Failed to encode stats: %v", err)
+		}
+		fmt.Println(string(data))
+	case "csv":
+		if err := writeStatsCSV(stats); err != nil {
+			log.Fatalf("Failed to write stats: %v", err)
+		}
+	default:
+		printStats(stats, *staleAfter)
+	}
+}
+
+// writeStatsCSV writes per-snapshot stats as CSV (one row per snapshot)
+func writeStatsCSV(stats usecases.RadarStats) error {
+	w := csv.NewWriter(os.Stdout)
+
+	header := []string{"date", "total"}
+	for _, c := range stats.Snapshots[0].ByRing {
+		header = append(header, "ring:"+c.Name)
+	}
+	for _, c := range stats.Snapshots[0].ByQuadrant {
+		header = append(header, "quadrant:"+c.Name)
+	}
+	header = append(header, "new", "moved", "deleted")
+	if err := w.Write(header); err != nil {
+		return err
+	}
+
+	for _, s := range stats.Snapshots {
+		row := []string{s.Date, strconv.Itoa(s.Total)}
+		for _, c := range s.ByRing {
+			row = append(row, strconv.Itoa(c.Count))
+		}
+		for _, c := range s.ByQuadrant {
+			row = append(row, strconv.Itoa(c.Count))
+		}
+		row = append(row, strconv.Itoa(s.New), strconv.Itoa(s.Moved), strconv.Itoa(s.Deleted))
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+// printStats prints stats in human-readable form
+func printStats(stats usecases.RadarStats, staleAfter int) {
+	fmt.Printf("Technologies by ring:\n\n")
+	fmt.Printf("  %-12s %6s", "Date", "Total")
+	for _, c := range stats.Snapshots[0].ByRing {
+		fmt.Printf(" %8s", c.Name)
+	}
+	fmt.Printf(" %6s %6s %8s\n", "New", "Moved", "Deleted")
+	for _, s := range stats.Snapshots {
+		fmt.Printf("  %-12s %6d", s.Date, s.Total)
+		for _, c := range s.ByRing {
+			fmt.Printf(" %8d", c.Count)
+		}
+		fmt.Printf(" %6d %6d %8d\n", s.New, s.Moved, s.Deleted)
+	}
+
+	fmt.Printf("\nTechnologies by quadrant:\n\n")
+	fmt.Printf("  %-12s", "Date")
+	for _, c := range stats.Snapshots[0].ByQuadrant {
+		fmt.Printf(" %14s", c.Name)
+	}
+	fmt.Println()
+	for _, s := range stats.Snapshots {
+		fmt.Printf("  %-12s", s.Date)
+		for _, c := range s.ByQuadrant {
+			fmt.Printf(" %14d", c.Count)
+		}
+		fmt.Println()
+	}
+
+	fmt.Printf("\nAverage time before promotion to an inner ring:\n\n")
+	if len(stats.Promotions) == 0 {
+		fmt.Printf("  no promotions yet\n")
+	}
+	for _, p := range stats.Promotions {
+		fmt.Printf("  %-10s %.1f period(s)", p.Ring, p.AveragePeriods)
+		if p.AverageDays > 0 {
+			fmt.Printf(", %.0f day(s)", p.AverageDays)
+		}
+		fmt.Printf(" (%d promotion(s))\n", p.Promotions)
+	}
+
+	if staleAfter > 0 {
+		fmt.Printf("\nStale technologies (same ring for %d+ periods):\n\n", staleAfter)
+		if len(stats.Stale) == 0 {
+			fmt.Printf("  none\n")
+		}
+		for _, s := range stats.Stale {
+			fmt.Printf("  %-20s %-10s since %s (%d periods)\n", s.Name, s.Ring, s.Since, s.Periods)
+		}
+	}
+	fmt.Println()
+}
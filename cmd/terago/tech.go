@@ -0,0 +1,121 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/ekalinin/terago/pkg/core"
+	"github.com/ekalinin/terago/pkg/usecases"
+)
+
+func techCommand(args []string) {
+	if len(args) < 1 {
+		printTechUsage()
+		os.Exit(1)
+	}
+
+	action := args[0]
+	switch action {
+	case "add", "move", "remove", "edit":
+	case "help", "-h", "-help", "--help":
+		printTechUsage()
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown tech action: %s\n\n", action)
+		printTechUsage()
+		os.Exit(1)
+	}
+
+	fs := flag.NewFlagSet("tech "+action, flag.ExitOnError)
+
+	inputDir := fs.String("input", "", "Directory path containing YAML files")
+	metaPath := fs.String("meta", "", "Path to meta.yaml file (optional)")
+	filePath := fs.String("file", "", "Technologies file to change (default: latest snapshot in input directory)")
+	ring := fs.String("ring", "", "Ring of the technology")
+	quadrant := fs.String("quadrant", "", "Quadrant of the technology")
+	description := fs.String("description", "", "Description of the technology")
+	rename := fs.String("rename", "", "New name of the technology (edit only)")
+	lenient := fs.Bool("lenient", false, "Allow unknown keys in meta and technology files")
+
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage:\n")
+		fmt.Fprintf(os.Stderr, "  terago tech %s <name> -input <directory> [options]\n\n", action)
+		fmt.Fprintf(os.Stderr, "Options:\n")
+		fs.PrintDefaults()
+	}
+
+	name, rest := splitPositional(args[1:], "")
+	fs.Parse(rest)
+	if name == "" && fs.NArg() > 0 {
+		name = fs.Arg(0)
+	}
+
+	if name == "" {
+		log.Fatalln("Error: Technology name is required")
+	}
+	if *inputDir == "" && *filePath == "" {
+		log.Fatalln("Error: Directory path is required (--input)")
+	}
+
+	meta, err := usecases.ReadMeta(*metaPath, *inputDir, false, *lenient)
+	if err != nil {
+		log.Fatalf("Failed to read meta: %v", err)
+	}
+
+	file := *filePath
+	if file == "" {
+		files, err := usecases.GetRadarFiles(*inputDir, meta)
+		if err != nil {
+			log.Fatalf("Failed to read input directory: %v", err)
+		}
+		if len(files) == 0 {
+			log.Fatalf("No radar files found in %s", *inputDir)
+		}
+		file = files[len(files)-1]
+	}
+
+	switch action {
+	case "add":
+		err = usecases.AddTechnology(file, meta, core.Technology{
+			Name:        name,
+			Ring:        *ring,
+			Quadrant:    *quadrant,
+			Description: *description,
+		})
+	case "move":
+		if *ring == "" {
+			log.Fatalln("Error: Ring is required (--ring)")
+		}
+		err = usecases.MoveTechnology(file, meta, name, *ring)
+	case "remove":
+		err = usecases.RemoveTechnology(file, name)
+	case "edit":
+		err = usecases.EditTechnology(file, meta, name, usecases.TechnologyUpdate{
+			Name:        *rename,
+			Ring:        *ring,
+			Quadrant:    *quadrant,
+			Description: *description,
+		})
+	}
+	if err != nil {
+		log.Fatalf("Failed to %s technology: %v", action, err)
+	}
+
+	log.Printf("Technology '%s': %s done in %s\n", name, action, file)
+}
+
+func printTechUsage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "  terago tech <action> <name> -input <directory> [options]\n\n")
+	fmt.Fprintf(os.Stderr, "Available Actions:\n")
+	fmt.Fprintf(os.Stderr, "  add      Add a technology (requires -ring, -quadrant, -description)\n")
+	fmt.Fprintf(os.Stderr, "  move     Move a technology to another ring (requires -ring)\n")
+	fmt.Fprintf(os.Stderr, "  remove   Remove a technology\n")
+	fmt.Fprintf(os.Stderr, "  edit     Change ring, quadrant, description or name (-rename) of a technology\n\n")
+	fmt.Fprintf(os.Stderr, "Example:\n")
+	fmt.Fprintf(os.Stderr, "  terago tech move Kubernetes -ring Adopt -input ./data\n")
+	fmt.Fprintf(os.Stderr, "  terago tech add Kafka -ring Trial -quadrant Platforms -description \"Event streaming\" -input ./data\n\n")
+	fmt.Fprintf(os.Stderr, "Use \"terago tech <action> -h\" for more information about an action.\n")
+}
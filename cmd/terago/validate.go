@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/ekalinin/terago/pkg/usecases"
 )
@@ -16,66 +17,102 @@ func validateCommand(args []string) {
 	inputDir := fs.String("input", "", "Directory path containing YAML files")
 	metaPath := fs.String("meta", "", "Path to meta.yaml file (optional)")
 	verbose := fs.Bool("verbose", false, "Verbose output - show status for each file")
+	format := fs.String("format", "text", "Output format: text, json, junit or sarif")
+	lenient := fs.Bool("lenient", false, "Allow unknown keys in meta and technology files")
+	failOnWarnings := fs.Bool("fail-on-warnings", false, "Exit with code 2 if there are warnings but no errors")
+	git := addGitInputFlags(fs)
 
 	fs.Usage = func() {
 		fmt.Fprintf(os.Stderr, "Usage:\n")
 		fmt.Fprintf(os.Stderr, "  terago validate -input <directory> [options]\n\n")
 		fmt.Fprintf(os.Stderr, "Example:\n")
 		fmt.Fprintf(os.Stderr, "  terago validate -input ./data\n")
-		fmt.Fprintf(os.Stderr, "  terago validate -input ./data --verbose\n\n")
+		fmt.Fprintf(os.Stderr, "  terago validate -input ./data --verbose\n")
+		fmt.Fprintf(os.Stderr, "  terago validate -input ./data --format sarif > terago.sarif\n")
+		fmt.Fprintf(os.Stderr, "  terago validate -from-git ./radar.yaml\n")
+		fmt.Fprintf(os.Stderr, "  terago validate -input ./data --fail-on-warnings\n\n")
+		fmt.Fprintf(os.Stderr, "Exit codes:\n")
+		fmt.Fprintf(os.Stderr, "  0 - no errors, 1 - errors found, 2 - warnings only with --fail-on-warnings\n\n")
 		fmt.Fprintf(os.Stderr, "Options:\n")
 		fs.PrintDefaults()
 	}
 
 	fs.Parse(args)
 
+	*inputDir = git.inputDir(*inputDir)
 	if *inputDir == "" {
 		log.Fatalln("Error: Directory path is required (--input)")
 	}
+	if *format != "text" && *format != "json" && *format != "junit" && *format != "sarif" {
+		log.Fatalf("Error: Unknown format '%s' (use text, json, junit or sarif)", *format)
+	}
 
 	// Read meta configuration
-	meta, err := usecases.ReadMeta(*metaPath, *inputDir, false)
+	meta, err := usecases.ReadMeta(*metaPath, *inputDir, false, *lenient)
 	if err != nil {
 		log.Fatalf("Failed to read meta: %v", err)
 	}
 
-	// Get all valid YAML files
-	validFiles, err := usecases.GetRadarFiles(*inputDir, meta)
+	var report usecases.ValidationReport
+	if git.enabled() {
+		report, err = usecases.ValidateRadarFromGit(*git.file, meta, *git.tags, time.Now(), *lenient)
+	} else {
+		report, err = usecases.ValidateRadar(*inputDir, meta, time.Now(), *lenient)
+	}
 	if err != nil {
-		log.Fatalf("Failed to read input directory: %v", err)
+		log.Fatalf("Failed to validate: %v", err)
 	}
 
-	if len(validFiles) == 0 {
+	if len(report.Files) == 0 && *format == "text" {
 		fmt.Println("No radar files found in", *inputDir)
 		return
 	}
 
-	// Validate each file
-	errorCount := 0
-	for _, file := range validFiles {
-		fileName := filepath.Base(file)
-		err := usecases.ValidateTechnologiesFile(file, meta)
-
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "ERROR: %s - %v\n", fileName, err)
-			errorCount++
-		} else {
-			if *verbose {
-				fmt.Printf("OK: %s\n", fileName)
-			}
-		}
+	switch *format {
+	case "json":
+		err = usecases.WriteValidationJSON(os.Stdout, report)
+	case "junit":
+		err = usecases.WriteValidationJUnit(os.Stdout, report)
+	case "sarif":
+		err = usecases.WriteValidationSARIF(os.Stdout, report)
+	default:
+		printValidationReport(report, *verbose)
+	}
+	if err != nil {
+		log.Fatalf("Failed to write report: %v", err)
 	}
 
-	// Print summary
-	if errorCount > 0 {
-		fmt.Fprintf(os.Stderr, "\nValidation completed with errors: %d file(s) failed, %d file(s) passed\n",
-			errorCount, len(validFiles)-errorCount)
+	// Exit codes: 1 - errors, 2 - warnings only (with --fail-on-warnings)
+	if report.ErrorCount() > 0 {
 		os.Exit(1)
-	} else {
-		if !*verbose {
-			fmt.Printf("OK: %d file(s) processed\n", len(validFiles))
-		} else {
-			fmt.Printf("\nOK: %d file(s) processed\n", len(validFiles))
+	}
+	if *failOnWarnings && report.WarningCount() > 0 {
+		os.Exit(2)
+	}
+}
+
+// printValidationReport prints every problem in "file:line:col: message" format and a summary
+func printValidationReport(report usecases.ValidationReport, verbose bool) {
+	for _, f := range report.Files {
+		for _, e := range f.Errors {
+			fmt.Fprintln(os.Stderr, e.String())
 		}
+		if verbose && f.Errors.ErrorCount() == 0 {
+			fmt.Printf("OK: %s\n", filepath.Base(f.File))
+		}
+	}
+
+	if failed := report.FailedFiles(); failed > 0 {
+		fmt.Fprintf(os.Stderr, "\nValidation completed with errors: %d error(s) in %d file(s), %d file(s) passed\n",
+			report.ErrorCount(), failed, len(report.Files)-failed)
+		return
+	}
+	if verbose {
+		fmt.Println()
+	}
+	fmt.Printf("OK: %d file(s) processed", len(report.Files))
+	if warnings := report.WarningCount(); warnings > 0 {
+		fmt.Printf(", %d warning(s)", warnings)
 	}
+	fmt.Println()
 }
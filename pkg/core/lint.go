@@ -0,0 +1,90 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+)
+
+// LintConfig represents the policy lint rules enabled for a radar.
+// It is set in the lint section of meta.yaml or in a .terago-lint.yaml file.
+type LintConfig struct {
+	Rules map[string]LintRuleConfig `yaml:"rules"`
+}
+
+// LintRuleConfig represents the settings of a single lint rule.
+// Only the options relevant to the rule are used.
+type LintRuleConfig struct {
+	// Severity is error, warning (default) or off
+	Severity string `yaml:"severity,omitempty"`
+	// Min and Max bound a length (description-length)
+	Min int `yaml:"min,omitempty"`
+	Max int `yaml:"max,omitempty"`
+	// MaxSteps is the largest allowed ring change per period (ring-jump)
+	MaxSteps int `yaml:"maxSteps,omitempty"`
+	// Rings the rule applies to (no-direct-adopt, hold-replaced-by)
+	Rings []string `yaml:"rings,omitempty"`
+	// Tags of which at least one is required (required-tags)
+	Tags []string `yaml:"tags,omitempty"`
+}
+
+// LintSeverityOff disables a lint rule
+const LintSeverityOff = "off"
+
+// RuleSeverity returns the severity of the rule, or LintSeverityOff if the rule is not enabled
+func (c LintConfig) RuleSeverity(name string) string {
+	rule, ok := c.Rules[name]
+	if !ok {
+		return LintSeverityOff
+	}
+	if rule.Severity == "" {
+		return SeverityWarning
+	}
+	return rule.Severity
+}
+
+// Names of the built-in lint rules
+const (
+	LintDescriptionLength = "description-length"
+	LintNoDirectAdopt     = "no-direct-adopt"
+	LintRingJump          = "ring-jump"
+	LintHoldReplacedBy    = "hold-replaced-by"
+	LintRequiredTags      = "required-tags"
+)
+
+// lintRuleNames are the names of the known lint rules, checked by Validate
+var lintRuleNames = Set[string]{
+	LintDescriptionLength: {},
+	LintNoDirectAdopt:     {},
+	LintRingJump:          {},
+	LintHoldReplacedBy:    {},
+	LintRequiredTags:      {},
+}
+
+// RegisterLintRuleName makes the name of a custom lint rule known to Validate
+func RegisterLintRuleName(name string) {
+	lintRuleNames[name] = struct{}{}
+}
+
+// Validate returns the problems of the configuration: rules that are not known
+// and severities other than error, warning and off, sorted by rule name
+func (c LintConfig) Validate() []string {
+	names := make([]string, 0, len(c.Rules))
+	for name := range c.Rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var problems []string
+	for _, name := range names {
+		if _, ok := lintRuleNames[name]; !ok {
+			problems = append(problems, fmt.Sprintf("unknown lint rule '%s'", name))
+			continue
+		}
+		switch severity := c.RuleSeverity(name); severity {
+		case SeverityError, SeverityWarning, LintSeverityOff:
+		default:
+			problems = append(problems, fmt.Sprintf("invalid severity '%s' of lint rule '%s' (use error, warning or off)", severity, name))
+		}
+	}
+	return problems
+}
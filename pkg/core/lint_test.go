@@ -0,0 +1,41 @@
+package core
+
+import "testing"
+
+func TestLintConfigRuleSeverity(t *testing.T) {
+	config := LintConfig{Rules: map[string]LintRuleConfig{
+		"ring-jump":          {},
+		"description-length": {Severity: SeverityError},
+		"required-tags":      {Severity: LintSeverityOff},
+	}}
+
+	tests := map[string]string{
+		"ring-jump":          SeverityWarning,
+		"description-length": SeverityError,
+		"required-tags":      LintSeverityOff,
+		"no-direct-adopt":    LintSeverityOff,
+	}
+	for rule, want := range tests {
+		if got := config.RuleSeverity(rule); got != want {
+			t.Errorf("RuleSeverity(%q) = %q, want %q", rule, got, want)
+		}
+	}
+}
+
+func TestLintConfigValidate(t *testing.T) {
+	config := LintConfig{Rules: map[string]LintRuleConfig{
+		LintRingJump:     {Severity: SeverityError},
+		"custom-rule":    {},
+		LintRequiredTags: {Severity: "fatal"},
+	}}
+	problems := config.Validate()
+	if len(problems) != 2 || problems[0] != "unknown lint rule 'custom-rule'" {
+		t.Errorf("Expected unknown rule and invalid severity, got %v", problems)
+	}
+
+	RegisterLintRuleName("custom-rule")
+	defer delete(lintRuleNames, "custom-rule")
+	if problems := config.Validate(); len(problems) != 1 {
+		t.Errorf("Expected only the invalid severity after registering the rule, got %v", problems)
+	}
+}
@@ -0,0 +1,68 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// MaxAge represents the maximum time a technology may stay in a ring
+// before it should be reviewed. It is measured either in snapshots (periods)
+// or in calendar units.
+type MaxAge struct {
+	Count int
+	Unit  string // one of "periods", "days", "weeks", "months", "years"
+}
+
+var maxAgePattern = regexp.MustCompile(`^\s*(\d+)\s*([a-z]*)\s*$`)
+
+var maxAgeUnits = map[string]string{
+	"": "periods", "p": "periods", "period": "periods", "periods": "periods",
+	"d": "days", "day": "days", "days": "days",
+	"w": "weeks", "week": "weeks", "weeks": "weeks",
+	"m": "months", "month": "months", "months": "months",
+	"y": "years", "year": "years", "years": "years",
+}
+
+// ParseMaxAge parses a max age like "2" or "2 periods" (snapshots), "90d", "6 months" or "1y".
+func ParseMaxAge(s string) (MaxAge, error) {
+	m := maxAgePattern.FindStringSubmatch(s)
+	if m == nil {
+		return MaxAge{}, fmt.Errorf("invalid maxAge '%s'", s)
+	}
+	unit, ok := maxAgeUnits[m[2]]
+	if !ok {
+		return MaxAge{}, fmt.Errorf("invalid maxAge '%s': unknown unit '%s'", s, m[2])
+	}
+	count, err := strconv.Atoi(m[1])
+	if err != nil || count <= 0 {
+		return MaxAge{}, fmt.Errorf("invalid maxAge '%s': must be a positive number", s)
+	}
+	return MaxAge{Count: count, Unit: unit}, nil
+}
+
+// IsPeriods reports whether the age is measured in snapshots.
+func (a MaxAge) IsPeriods() bool {
+	return a.Unit == "periods"
+}
+
+// Deadline returns the time after which a technology in the ring since the given time is overdue.
+// It must not be called for ages measured in periods.
+func (a MaxAge) Deadline(since time.Time) time.Time {
+	switch a.Unit {
+	case "days":
+		return since.AddDate(0, 0, a.Count)
+	case "weeks":
+		return since.AddDate(0, 0, 7*a.Count)
+	case "months":
+		return since.AddDate(0, a.Count, 0)
+	default:
+		return since.AddDate(a.Count, 0, 0)
+	}
+}
+
+// String returns the max age in human-readable form (e.g. "6 months").
+func (a MaxAge) String() string {
+	return fmt.Sprintf("%d %s", a.Count, a.Unit)
+}
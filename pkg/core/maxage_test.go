@@ -0,0 +1,59 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseMaxAge(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected MaxAge
+		wantErr  bool
+	}{
+		{"2", MaxAge{2, "periods"}, false},
+		{"2 periods", MaxAge{2, "periods"}, false},
+		{"3p", MaxAge{3, "periods"}, false},
+		{"90d", MaxAge{90, "days"}, false},
+		{"2 weeks", MaxAge{2, "weeks"}, false},
+		{"6 months", MaxAge{6, "months"}, false},
+		{"6m", MaxAge{6, "months"}, false},
+		{"1y", MaxAge{1, "years"}, false},
+		{"", MaxAge{}, true},
+		{"0", MaxAge{}, true},
+		{"six months", MaxAge{}, true},
+		{"6 fortnights", MaxAge{}, true},
+	}
+
+	for _, test := range tests {
+		result, err := ParseMaxAge(test.input)
+		if (err != nil) != test.wantErr {
+			t.Errorf("ParseMaxAge(%q) error = %v, wantErr %v", test.input, err, test.wantErr)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("ParseMaxAge(%q) = %+v; expected %+v", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestMaxAgeDeadline(t *testing.T) {
+	since := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		age      MaxAge
+		expected time.Time
+	}{
+		{MaxAge{10, "days"}, time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)},
+		{MaxAge{2, "weeks"}, time.Date(2024, 2, 14, 0, 0, 0, 0, time.UTC)},
+		{MaxAge{6, "months"}, time.Date(2024, 7, 31, 0, 0, 0, 0, time.UTC)},
+		{MaxAge{1, "years"}, time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		result := test.age.Deadline(since)
+		if !result.Equal(test.expected) {
+			t.Errorf("%s deadline = %v; expected %v", test.age, result, test.expected)
+		}
+	}
+}
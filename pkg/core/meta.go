@@ -1,5 +1,11 @@
 package core
 
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
 // Quadrant represents a quadrant of the radar.
 type Quadrant struct {
 	Name  string `yaml:"name"`
@@ -10,6 +16,10 @@ type Quadrant struct {
 type Ring struct {
 	Name  string `yaml:"name"`
 	Alias string `yaml:"alias"`
+	// Persist marks rings whose entries are carried over to a new snapshot
+	Persist bool `yaml:"persist,omitempty"`
+	// MaxAge is how long an entry may stay in the ring before review, e.g. "2" (periods) or "6 months"
+	MaxAge string `yaml:"maxAge,omitempty"`
 }
 
 // MetaFile represents the metadata of the radar file.
@@ -18,7 +28,13 @@ type MetaFile struct {
 	Description     string     `yaml:"description"`
 	Quadrants       []Quadrant `yaml:"quadrants"`
 	Rings           []Ring     `yaml:"rings"`
-	FileNamePattern string     `yaml:"fileNamePattern"`
+	FileNamePattern string     `yaml:"fileNamePattern,omitempty"`
+	// DateLayout is a Go time layout, "quarter" or "sprint" for dates in file names
+	DateLayout string `yaml:"dateLayout,omitempty"`
+	// SprintStart (YYYY-MM-DD) and SprintLength (e.g. "2 weeks") define sprint dates
+	SprintStart  string     `yaml:"sprintStart,omitempty"`
+	SprintLength string     `yaml:"sprintLength,omitempty"`
+	Lint         LintConfig `yaml:"lint,omitempty"`
 }
 
 // Meta represents the metadata of the radar data used in main logic.
@@ -28,6 +44,10 @@ type Meta struct {
 	Quadrants       []Quadrant  `yaml:"quadrants"`
 	Rings           []Ring      `yaml:"rings"`
 	FileNamePattern string      `yaml:"fileNamePattern"`
+	DateLayout      string      `yaml:"dateLayout"`
+	SprintStart     string      `yaml:"sprintStart"`
+	SprintLength    string      `yaml:"sprintLength"`
+	Lint            LintConfig  `yaml:"lint"`
 	ringSet         Set[string] `yaml:"-"`
 	quadrantSet     Set[string] `yaml:"-"`
 }
@@ -37,7 +57,7 @@ var defaultMeta = Meta{
 	Description:     "Technology Radar",
 	Quadrants:       DefaultQuadrants,
 	Rings:           DefaultRings,
-	FileNamePattern: `^\d{8}\.yaml$`, // default YYYYMMDD.yaml pattern
+	FileNamePattern: `^\d{8}\.(yaml|yml|json|toml)$`, // default YYYYMMDD.<ext> pattern
 }
 
 // NewMeta creates a new Meta with initialized ringSet and quadrantSet
@@ -75,10 +95,84 @@ func NewMetaFromFile(metaFile MetaFile) Meta {
 	if metaFile.FileNamePattern != "" {
 		m.FileNamePattern = metaFile.FileNamePattern
 	}
+	m.DateLayout = metaFile.DateLayout
+	m.SprintStart = metaFile.SprintStart
+	m.SprintLength = metaFile.SprintLength
+	m.Lint = metaFile.Lint
 
 	return m
 }
 
+// Validate checks the meta configuration: rings and quadrants must be defined
+// with unique non-empty names and aliases, ring maxAge values must be valid,
+// fileNamePattern must be a valid regular expression and the sprint notation
+// needs sprintStart and sprintLength.
+func (m *Meta) Validate() error {
+	var problems []string
+
+	if len(m.Quadrants) == 0 {
+		problems = append(problems, "no quadrants defined")
+	}
+	quadrantNames := make(Set[string])
+	for i, q := range m.Quadrants {
+		problems = append(problems, checkNameAndAlias("quadrant", i, q.Name, q.Alias, quadrantNames)...)
+	}
+
+	if len(m.Rings) == 0 {
+		problems = append(problems, "no rings defined")
+	}
+	ringNames := make(Set[string])
+	for i, r := range m.Rings {
+		problems = append(problems, checkNameAndAlias("ring", i, r.Name, r.Alias, ringNames)...)
+		if r.MaxAge != "" {
+			if _, err := ParseMaxAge(r.MaxAge); err != nil {
+				problems = append(problems, fmt.Sprintf("ring '%s': %v", r.Name, err))
+			}
+		}
+	}
+
+	if _, err := regexp.Compile(m.FileNamePattern); err != nil {
+		problems = append(problems, fmt.Sprintf("invalid fileNamePattern '%s': %v", m.FileNamePattern, err))
+	}
+
+	if m.DateLayout == DateLayoutSprint {
+		if _, _, err := m.sprintCalendar(); err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+
+	problems = append(problems, m.Lint.Validate()...)
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid meta: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// checkNameAndAlias checks that the name is set and that the name and alias are not used
+// by another item of the same kind. Used names and aliases are added to seen.
+func checkNameAndAlias(kind string, index int, name, alias string, seen Set[string]) []string {
+	var problems []string
+	if name == "" {
+		problems = append(problems, fmt.Sprintf("%s #%d has no name", kind, index+1))
+	}
+	values := []string{name}
+	if alias != name {
+		values = append(values, alias)
+	}
+	for _, value := range values {
+		if value == "" {
+			continue
+		}
+		if _, exists := seen[value]; exists {
+			problems = append(problems, fmt.Sprintf("duplicate %s name or alias '%s'", kind, value))
+			continue
+		}
+		seen[value] = struct{}{}
+	}
+	return problems
+}
+
 // PopulateSets fills the ringSet and quadrantSet with values from Rings and Quadrants
 func (m *Meta) PopulateSets() {
 	// Populate ringSet
@@ -102,6 +196,22 @@ func (m *Meta) IsValidRing(ring string) bool {
 	return exists
 }
 
+// FindRing returns the ring with the given name or alias
+func (m *Meta) FindRing(ring string) (Ring, bool) {
+	for _, r := range m.Rings {
+		if r.Name == ring || r.Alias == ring {
+			return r, true
+		}
+	}
+	return Ring{}, false
+}
+
+// IsPersistentRing checks if entries of a ring should be carried over to a new snapshot
+func (m *Meta) IsPersistentRing(ring string) bool {
+	r, ok := m.FindRing(ring)
+	return ok && r.Persist
+}
+
 // IsValidQuadrant checks if a quadrant is valid according to the meta configuration
 func (m *Meta) IsValidQuadrant(quadrant string) bool {
 	_, exists := m.quadrantSet[quadrant]
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -229,7 +230,7 @@ func TestNewMetaFromFile(t *testing.T) {
 	meta1 := NewMetaFromFile(metaFile1)
 
 	// Check that default FileNamePattern is set
-	expectedDefaultPattern := `^\d{8}\.yaml$`
+	expectedDefaultPattern := `^\d{8}\.(yaml|yml|json|toml)$`
 	if meta1.FileNamePattern != expectedDefaultPattern {
 		t.Errorf("Expected default FileNamePattern '%s', got '%s'", expectedDefaultPattern, meta1.FileNamePattern)
 	}
@@ -264,3 +265,102 @@ func TestNewMetaFromFile(t *testing.T) {
 		t.Errorf("Expected description '%s', got '%s'", metaFile2.Description, meta2.Description)
 	}
 }
+
+func TestMetaIsPersistentRing(t *testing.T) {
+	meta := NewMeta("", "", nil, []Ring{
+		{Name: "Adopt", Alias: "adopt", Persist: true},
+		{Name: "Trial", Alias: "trial"},
+	})
+
+	tests := []struct {
+		ring     string
+		expected bool
+	}{
+		{"Adopt", true},
+		{"adopt", true},
+		{"Trial", false},
+		{"Unknown", false},
+	}
+
+	for _, test := range tests {
+		result := meta.IsPersistentRing(test.ring)
+		if result != test.expected {
+			t.Errorf("IsPersistentRing(%s) = %v; expected %v", test.ring, result, test.expected)
+		}
+	}
+}
+
+func TestMetaValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		meta    func() Meta
+		wantErr string
+	}{
+		{"default meta", DefaultMeta, ""},
+		{"same name and alias", func() Meta {
+			return NewMeta("", "", []Quadrant{{Name: "Tools", Alias: "Tools"}}, nil)
+		}, ""},
+		{"no quadrants", func() Meta {
+			return NewMeta("", "", []Quadrant{}, nil)
+		}, "no quadrants defined"},
+		{"no rings", func() Meta {
+			return NewMeta("", "", nil, []Ring{})
+		}, "no rings defined"},
+		{"duplicate quadrant name", func() Meta {
+			return NewMeta("", "", []Quadrant{{Name: "Tools", Alias: "tools"}, {Name: "Tools", Alias: "tools2"}}, nil)
+		}, "duplicate quadrant name or alias 'Tools'"},
+		{"alias of another ring", func() Meta {
+			return NewMeta("", "", nil, []Ring{{Name: "Adopt", Alias: "adopt"}, {Name: "adopt", Alias: "trial"}})
+		}, "duplicate ring name or alias 'adopt'"},
+		{"ring without name", func() Meta {
+			return NewMeta("", "", nil, []Ring{{Alias: "adopt"}})
+		}, "ring #1 has no name"},
+		{"invalid maxAge", func() Meta {
+			return NewMeta("", "", nil, []Ring{{Name: "Adopt", Alias: "adopt", MaxAge: "soon"}})
+		}, "ring 'Adopt'"},
+		{"invalid fileNamePattern", func() Meta {
+			m := DefaultMeta()
+			m.FileNamePattern = `^(\d{8}\.yaml$`
+			return m
+		}, "invalid fileNamePattern"},
+		{"sprint without start", func() Meta {
+			m := DefaultMeta()
+			m.DateLayout = DateLayoutSprint
+			m.SprintLength = "2 weeks"
+			return m
+		}, "invalid sprintStart"},
+		{"unknown lint rule", func() Meta {
+			m := DefaultMeta()
+			m.Lint = LintConfig{Rules: map[string]LintRuleConfig{"ring-jupm": {}}}
+			return m
+		}, "unknown lint rule 'ring-jupm'"},
+		{"invalid lint severity", func() Meta {
+			m := DefaultMeta()
+			m.Lint = LintConfig{Rules: map[string]LintRuleConfig{LintRingJump: {Severity: "fatal"}}}
+			return m
+		}, "invalid severity 'fatal' of lint rule 'ring-jump'"},
+		{"sprint length in periods", func() Meta {
+			m := DefaultMeta()
+			m.DateLayout = DateLayoutSprint
+			m.SprintStart = "2024-01-01"
+			m.SprintLength = "2"
+			return m
+		}, "invalid sprintLength"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta := tt.meta()
+			err := meta.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultDateLayout is the layout of snapshot dates in default YYYYMMDD file names
+const DefaultDateLayout = "20060102"
+
+// Notations of dateLayout besides Go time layouts
+const (
+	// DateLayoutQuarter is a year and a quarter, e.g. "2024-Q1" or "2024Q1"
+	DateLayoutQuarter = "quarter"
+	// DateLayoutSprint is a sprint number counted from sprintStart, e.g. "12"
+	DateLayoutSprint = "sprint"
+)
+
+// SnapshotDateGroup is the name of the fileNamePattern capture group holding the snapshot date,
+// e.g. `^radar-(?P<date>\d{4}-Q[1-4])\.yaml$`
+const SnapshotDateGroup = "date"
+
+var quarterPattern = regexp.MustCompile(`(?i)^(\d{4})\W*Q([1-4])$`)
+
+var sprintPattern = regexp.MustCompile(`(?i)^(?:sprint\W*)?(\d+)$`)
+
+// maxSprint is the largest supported sprint number, over 270 years of daily sprints
+const maxSprint = 100000
+
+// SnapshotTime returns the time of a snapshot from its file name. The date is the
+// SnapshotDateGroup capture group of fileNamePattern, or date (the file name without extension)
+// when the pattern has no such group. The date is parsed according to dateLayout.
+// Without dateLayout, dates that are not in the default YYYYMMDD layout have zero time,
+// with dateLayout they are an error.
+func (m *Meta) SnapshotTime(fileName, date string) (time.Time, error) {
+	if pattern, err := regexp.Compile(m.FileNamePattern); err == nil {
+		if i := pattern.SubexpIndex(SnapshotDateGroup); i >= 0 {
+			if match := pattern.FindStringSubmatch(fileName); match != nil {
+				date = match[i]
+			}
+		}
+	}
+
+	if m.DateLayout == "" {
+		t, err := time.Parse(DefaultDateLayout, date)
+		if err != nil {
+			return time.Time{}, nil
+		}
+		return t, nil
+	}
+
+	t, err := m.parseSnapshotDate(date)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date '%s' in file name %s: %v", date, fileName, err)
+	}
+	return t, nil
+}
+
+// parseSnapshotDate parses a date according to dateLayout.
+func (m *Meta) parseSnapshotDate(date string) (time.Time, error) {
+	switch m.DateLayout {
+	case DateLayoutQuarter:
+		match := quarterPattern.FindStringSubmatch(date)
+		if match == nil {
+			return time.Time{}, fmt.Errorf("expected a quarter like 2024-Q1")
+		}
+		year, _ := strconv.Atoi(match[1])
+		quarter, _ := strconv.Atoi(match[2])
+		return time.Date(year, time.Month(3*(quarter-1)+1), 1, 0, 0, 0, 0, time.UTC), nil
+	case DateLayoutSprint:
+		match := sprintPattern.FindStringSubmatch(strings.TrimSpace(date))
+		if match == nil {
+			return time.Time{}, fmt.Errorf("expected a sprint number")
+		}
+		sprint, err := strconv.Atoi(match[1])
+		if err != nil || sprint < 1 || sprint > maxSprint {
+			return time.Time{}, fmt.Errorf("sprint number %s is out of range 1-%d", match[1], maxSprint)
+		}
+		start, length, err := m.sprintCalendar()
+		if err != nil {
+			return time.Time{}, err
+		}
+		// Sprint n starts n-1 sprint lengths after the first one
+		return MaxAge{Count: length.Count * (sprint - 1), Unit: length.Unit}.Deadline(start), nil
+	default:
+		return time.Parse(m.DateLayout, date)
+	}
+}
+
+// sprintCalendar returns the start of the first sprint and the sprint length.
+func (m *Meta) sprintCalendar() (time.Time, MaxAge, error) {
+	start, err := time.Parse("2006-01-02", m.SprintStart)
+	if err != nil {
+		return time.Time{}, MaxAge{}, fmt.Errorf("invalid sprintStart '%s': expected YYYY-MM-DD", m.SprintStart)
+	}
+	length, err := ParseMaxAge(m.SprintLength)
+	if err != nil || length.IsPeriods() {
+		return time.Time{}, MaxAge{}, fmt.Errorf("invalid sprintLength '%s': expected a duration like '2 weeks'", m.SprintLength)
+	}
+	return start, length, nil
+}
+
+// FormatSnapshotTime returns the snapshot time for display: a quarter like "2024 Q1"
+// for the quarter notation, a date like "2024-01-31" otherwise.
+func (m *Meta) FormatSnapshotTime(t time.Time) string {
+	if m.DateLayout == DateLayoutQuarter {
+		return fmt.Sprintf("%d Q%d", t.Year(), (int(t.Month())-1)/3+1)
+	}
+	return t.Format("2006-01-02")
+}
+
+// Before reports whether the snapshot is earlier than the other one. Snapshots without
+// time (dates the file name doesn't tell) come first, ordered by date (file name); snapshots
+// with time follow, ordered by time and then by date. The order is a strict weak ordering,
+// so it can be used for sorting.
+func (tf *TechnologiesFile) Before(other TechnologiesFile) bool {
+	if tf.Time.IsZero() != other.Time.IsZero() {
+		return tf.Time.IsZero()
+	}
+	if !tf.Time.Equal(other.Time) {
+		return tf.Time.Before(other.Time)
+	}
+	return tf.Date < other.Date
+}
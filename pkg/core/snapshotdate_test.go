@@ -0,0 +1,81 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMetaSnapshotTime(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name     string
+		pattern  string
+		layout   string
+		fileName string
+		date     string
+		want     time.Time
+		wantErr  bool
+	}{
+		{"default layout", `^\d{8}\.yaml$`, "", "20240131.yaml", "20240131", date(2024, 1, 31), false},
+		{"unknown date without layout", `^radar-.*\.yaml$`, "", "radar-2024-01.yaml", "radar-2024-01", time.Time{}, false},
+		{"date group with layout", `^radar-(?P<date>\d{4}-\d{2}-\d{2})\.yaml$`, "2006-01-02", "radar-2024-03-15.yaml", "radar-2024-03-15", date(2024, 3, 15), false},
+		{"layout without group", `^\d{2}\.\d{2}\.\d{4}\.yaml$`, "02.01.2006", "15.03.2024.yaml", "15.03.2024", date(2024, 3, 15), false},
+		{"quarter", `^radar-(?P<date>\d{4}-Q[1-4])\.yaml$`, DateLayoutQuarter, "radar-2024-Q3.yaml", "radar-2024-Q3", date(2024, 7, 1), false},
+		{"quarter without separator", `^(?P<date>\d{4}q[1-4])\.yaml$`, DateLayoutQuarter, "2023q4.yaml", "2023q4", date(2023, 10, 1), false},
+		{"sprint", `^sprint-(?P<date>\d+)\.yaml$`, DateLayoutSprint, "sprint-3.yaml", "sprint-3", date(2024, 1, 29), false},
+		{"large sprint number", `^sprint-(?P<date>\d+)\.yaml$`, DateLayoutSprint, "sprint-1000.yaml", "sprint-1000", date(2024, 1, 1).AddDate(0, 0, 14*999), false},
+		{"sprint zero", `^sprint-(?P<date>\d+)\.yaml$`, DateLayoutSprint, "sprint-0.yaml", "sprint-0", time.Time{}, true},
+		{"sprint out of range", `^sprint-(?P<date>\d+)\.yaml$`, DateLayoutSprint, "sprint-99999999999999999999.yaml", "sprint-99999999999999999999", time.Time{}, true},
+		{"invalid date with layout", `^radar-(?P<date>.*)\.yaml$`, DateLayoutQuarter, "radar-2024.yaml", "radar-2024", time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta := DefaultMeta()
+			meta.FileNamePattern = tt.pattern
+			meta.DateLayout = tt.layout
+			meta.SprintStart = "2024-01-01"
+			meta.SprintLength = "2 weeks"
+
+			got, err := meta.SnapshotTime(tt.fileName, tt.date)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SnapshotTime() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("SnapshotTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMetaFormatSnapshotTime(t *testing.T) {
+	meta := DefaultMeta()
+	date := time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)
+	if got := meta.FormatSnapshotTime(date); got != "2024-08-01" {
+		t.Errorf("Expected 2024-08-01, got %s", got)
+	}
+	meta.DateLayout = DateLayoutQuarter
+	if got := meta.FormatSnapshotTime(date); got != "2024 Q3" {
+		t.Errorf("Expected 2024 Q3, got %s", got)
+	}
+}
+
+func TestTechnologiesFileBefore(t *testing.T) {
+	jan := TechnologiesFile{Date: "radar-Jan-2024", Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	dec := TechnologiesFile{Date: "radar-Dec-2023", Time: time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)}
+	if !dec.Before(jan) || jan.Before(dec) {
+		t.Error("Expected snapshots to be compared by time")
+	}
+
+	a, b := TechnologiesFile{Date: "a"}, TechnologiesFile{Date: "b"}
+	if !a.Before(b) || b.Before(a) {
+		t.Error("Expected snapshots without time to be compared by date")
+	}
+
+	// Snapshots without time come first, whatever their dates, so the order is transitive
+	z := TechnologiesFile{Date: "z"}
+	if !z.Before(dec) || dec.Before(z) || !a.Before(z) {
+		t.Error("Expected snapshots without time to come before snapshots with time")
+	}
+}
@@ -1,6 +1,9 @@
 package core
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // Technology represents a single technology entry in the radar
 type Technology struct {
@@ -9,31 +12,150 @@ type Technology struct {
 	Quadrant    string `yaml:"quadrant"`
 	Description string `yaml:"description"`
 	Info        string `yaml:"info,omitempty"`
+	// Date of the last review (YYYY-MM-DD), resets the ring maxAge clock
+	ReviewedAt string `yaml:"reviewedAt,omitempty"`
+	// Name of the technology to use instead (for Hold entries)
+	ReplacedBy string   `yaml:"replacedBy,omitempty"`
+	Tags       []string `yaml:"tags,omitempty"`
+	// Description rendered as HTML (Markdown descriptions of the directory layout only)
+	DescriptionHTML string `yaml:"-"`
 	// Used for tracking changes between periods
 	IsNew        bool   `yaml:"-"`
 	IsMoved      bool   `yaml:"-"`
 	IsDeleted    bool   `yaml:"-"`
 	PreviousRing string `yaml:"-"`
+	// Source positions of the technology and its fields (zero if unknown)
+	Positions TechnologyPositions `yaml:"-"`
+	// Rings of the technology in the merged radars (aggregated radars only)
+	Adoptions []Adoption `yaml:"-"`
+}
+
+// Adoption is the ring of a technology in one of the radars merged into an aggregated radar
+type Adoption struct {
+	Radar string `json:"radar"`
+	Ring  string `json:"ring"`
+}
+
+// RequiredTechnologyFields are the fields every technology must have
+var RequiredTechnologyFields = []string{"name", "ring", "quadrant", "description"}
+
+// FieldValue returns the value of a required field by its YAML key
+func (t *Technology) FieldValue(field string) string {
+	switch field {
+	case "name":
+		return t.Name
+	case "ring":
+		return t.Ring
+	case "quadrant":
+		return t.Quadrant
+	case "description":
+		return t.Description
+	}
+	return ""
+}
+
+// TechnologyPositions holds source positions of a technology entry and its required fields
+type TechnologyPositions struct {
+	Entry       Position
+	Name        Position
+	Ring        Position
+	Quadrant    Position
+	Description Position
+}
+
+// FieldPosition returns the source position of the field value,
+// falling back to the position of the technology entry itself
+func (t *Technology) FieldPosition(field string) Position {
+	var pos Position
+	switch field {
+	case "name":
+		pos = t.Positions.Name
+	case "ring":
+		pos = t.Positions.Ring
+	case "quadrant":
+		pos = t.Positions.Quadrant
+	case "description":
+		pos = t.Positions.Description
+	}
+	if pos.Line == 0 {
+		return t.Positions.Entry
+	}
+	return pos
 }
 
 // TechnologiesFile represents the structure of the YAML file
 type TechnologiesFile struct {
-	Date         string
+	// Date of the snapshot from the file name, not a key of the file
+	Date string `yaml:"-"`
+	// Time of the snapshot parsed from the file name (zero if unknown)
+	Time time.Time `yaml:"-"`
+	// Optional label of the snapshot, e.g. "2024 Q1 — Platform consolidation"
+	Title string `yaml:"title,omitempty"`
+	// Optional editorial intro in Markdown
+	Summary string `yaml:"summary,omitempty"`
+	// Optional publication date (YYYY-MM-DD)
+	PublishedAt string `yaml:"publishedAt,omitempty"`
+	// Draft snapshots are validated but not published
+	Draft        bool         `yaml:"draft,omitempty"`
 	Technologies []Technology `yaml:"technologies"`
 }
 
+// PublishedTime parses the publication date, it is zero if not set
+func (tf *TechnologiesFile) PublishedTime() (time.Time, error) {
+	if tf.PublishedAt == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse("2006-01-02", tf.PublishedAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid publishedAt '%s': expected YYYY-MM-DD", tf.PublishedAt)
+	}
+	return t, nil
+}
+
 // ValidateRingsAndQuadrants validates that all technologies in the file
-// have valid rings and quadrants according to the provided meta configuration
+// have valid rings and quadrants according to the provided meta configuration.
+// Only the first problem is returned, see RingAndQuadrantErrors for all of them.
 func (tf *TechnologiesFile) ValidateRingsAndQuadrants(meta Meta) error {
-	// Validate each technology
+	return tf.RingAndQuadrantErrors(meta).First()
+}
+
+// RingAndQuadrantErrors returns all technologies with invalid rings or quadrants
+// according to the provided meta configuration
+func (tf *TechnologiesFile) RingAndQuadrantErrors(meta Meta) ValidationErrors {
+	var errs ValidationErrors
 	for _, tech := range tf.Technologies {
-		if !meta.IsValidRing(tech.Ring) {
-			return fmt.Errorf("invalid ring '%s' in technology '%s'", tech.Ring, tech.Name)
-		}
-		if !meta.IsValidQuadrant(tech.Quadrant) {
-			return fmt.Errorf("invalid quadrant '%s' in technology '%s'", tech.Quadrant, tech.Name)
-		}
+		errs = append(errs, tech.RingAndQuadrantErrors(meta)...)
+	}
+
+	return errs
+}
+
+// RingAndQuadrantErrors checks the ring and quadrant of the technology
+// according to the provided meta configuration
+func (t *Technology) RingAndQuadrantErrors(meta Meta) ValidationErrors {
+	var errs ValidationErrors
+	if !meta.IsValidRing(t.Ring) {
+		errs = append(errs, ValidationError{
+			Technology: t.Name,
+			Field:      "ring",
+			Rule:       RuleInvalidRing,
+			Severity:   SeverityError,
+			Message:    fmt.Sprintf("invalid ring '%s' in technology '%s'", t.Ring, t.Name),
+			Line:       t.FieldPosition("ring").Line,
+			Column:     t.FieldPosition("ring").Column,
+		})
+	}
+	if !meta.IsValidQuadrant(t.Quadrant) {
+		errs = append(errs, ValidationError{
+			Technology: t.Name,
+			Field:      "quadrant",
+			Rule:       RuleInvalidQuadrant,
+			Severity:   SeverityError,
+			Message:    fmt.Sprintf("invalid quadrant '%s' in technology '%s'", t.Quadrant, t.Name),
+			Line:       t.FieldPosition("quadrant").Line,
+			Column:     t.FieldPosition("quadrant").Column,
+		})
 	}
 
-	return nil
+	return errs
 }
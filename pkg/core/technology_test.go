@@ -67,3 +67,82 @@ func TestTechnologiesFileValidateRingsAndQuadrants(t *testing.T) {
 		t.Error("ValidateRingsAndQuadrants with invalid quadrant should return error")
 	}
 }
+
+func TestTechnologiesFileRingAndQuadrantErrors(t *testing.T) {
+	meta := DefaultMeta()
+	tf := TechnologiesFile{
+		Technologies: []Technology{
+			{Name: "Go", Ring: "Adopt", Quadrant: "Languages"},
+			{Name: "Foo", Ring: "Bad", Quadrant: "Worse"},
+			{Name: "Bar", Ring: "Hold", Quadrant: "Nope"},
+		},
+	}
+
+	errs := tf.RingAndQuadrantErrors(meta)
+	if len(errs) != 3 {
+		t.Fatalf("Expected 3 errors, got %d: %v", len(errs), errs)
+	}
+	if errs[0].Technology != "Foo" || errs[0].Field != "ring" {
+		t.Errorf("Unexpected first error: %+v", errs[0])
+	}
+	if errs[2].Technology != "Bar" || errs[2].Field != "quadrant" {
+		t.Errorf("Unexpected last error: %+v", errs[2])
+	}
+
+	want := "invalid ring 'Bad' in technology 'Foo'; invalid quadrant 'Worse' in technology 'Foo'; invalid quadrant 'Nope' in technology 'Bar'"
+	if errs.Error() != want {
+		t.Errorf("Expected %q, got %q", want, errs.Error())
+	}
+	if err := tf.ValidateRingsAndQuadrants(meta); err == nil || err.Error() != errs[0].Message {
+		t.Errorf("Expected first error, got %v", err)
+	}
+	if err := (ValidationErrors{}).First(); err != nil {
+		t.Errorf("Expected nil for no errors, got %v", err)
+	}
+}
+
+func TestValidationErrorString(t *testing.T) {
+	tests := []struct {
+		err  ValidationError
+		want string
+	}{
+		{ValidationError{File: "a.yaml", Message: "bad", Line: 3, Column: 11}, "a.yaml:3:11: bad"},
+		{ValidationError{File: "a.yaml", Message: "bad", Line: 3}, "a.yaml:3: bad"},
+		{ValidationError{File: "a.yaml", Message: "bad"}, "a.yaml: bad"},
+	}
+	for _, tt := range tests {
+		if got := tt.err.String(); got != tt.want {
+			t.Errorf("Expected %q, got %q", tt.want, got)
+		}
+	}
+}
+
+func TestTechnologiesFilePublishedTime(t *testing.T) {
+	tests := []struct {
+		publishedAt string
+		expected    string
+		wantErr     bool
+	}{
+		{publishedAt: "", expected: "0001-01-01"},
+		{publishedAt: "2024-01-15", expected: "2024-01-15"},
+		{publishedAt: "20240115", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		file := TechnologiesFile{PublishedAt: tt.publishedAt}
+		got, err := file.PublishedTime()
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("PublishedTime(%q) should return error", tt.publishedAt)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("PublishedTime(%q) returned error: %v", tt.publishedAt, err)
+			continue
+		}
+		if got.Format("2006-01-02") != tt.expected {
+			t.Errorf("PublishedTime(%q) = %s, expected %s", tt.publishedAt, got.Format("2006-01-02"), tt.expected)
+		}
+	}
+}
@@ -16,12 +16,22 @@ type RadarEntry struct {
 	Link        string `json:"link"`
 	Active      bool   `json:"active"`
 	Description string `json:"description"`
+	// Description rendered as HTML, shown instead of the plain description if set
+	DescriptionHTML string `json:"descriptionHtml,omitempty"`
+	// Rings in the merged radars (aggregated radars only)
+	Adoptions []Adoption `json:"adoptions,omitempty"`
 }
 
 // RadarData represents the data needed for the HTML template
 type RadarData struct {
-	Title       string
-	Date        string
+	Title string
+	Date  string
+	// Optional label, intro (rendered from Markdown) and publication date of the snapshot
+	SnapshotTitle string
+	Summary       template.HTML
+	PublishedAt   string
+	// Draft is true for draft snapshots rendered with --include-drafts
+	Draft       bool
 	Version     string
 	GeneratedAt string
 	Entries     []RadarEntry
@@ -33,11 +43,68 @@ type RadarData struct {
 	RingsJSON     template.JS
 	DescriptionJS template.JS   // JavaScript for description modal
 	ChangesTable  template.HTML // HTML table with changes
+	// Radars merged into an aggregated radar and the table of their rings
+	AdoptionRadars []string
+	AdoptionTable  template.HTML
 	// Embedded JavaScript libraries (empty if using CDN)
 	D3JS    template.JS
 	RadarJS template.JS
 }
 
+// IndexData represents the data for the index page of a workspace
+type IndexData struct {
+	Title       string
+	Version     string
+	GeneratedAt string
+	Radars      []IndexRadar
+}
+
+// IndexRadar is a radar on the index page with its snapshots, latest first
+type IndexRadar struct {
+	Name        string
+	Title       string
+	Description string
+	// Path of the radar output and of its Atom feed relative to the index page
+	Path      string
+	Feed      string
+	Snapshots []IndexSnapshot
+}
+
+// IndexSnapshot is a snapshot of a radar on the index page
+type IndexSnapshot struct {
+	Date        string
+	Title       string
+	Summary     template.HTML
+	PublishedAt string
+	Draft       bool
+	// Link to the snapshot page relative to the index page
+	Link string
+}
+
+// CompareData represents the data for the page comparing two radars or two snapshots
+type CompareData struct {
+	Title       string
+	Version     string
+	GeneratedAt string
+	Left        ComparedRadar
+	Right       ComparedRadar
+	// HTML table rows with technologies whose rings differ
+	DisagreementTable template.HTML
+}
+
+// ComparedRadar is one side of the comparison page
+type ComparedRadar struct {
+	Name string
+	Date string
+	// Page is the rendered radar page, shown in a frame to keep the radars apart
+	Page string
+}
+
+// SetDisagreementTable sets the HTML table with technologies whose rings differ
+func (cd *CompareData) SetDisagreementTable(html string) {
+	cd.DisagreementTable = template.HTML(html)
+}
+
 // UpdateJSON updates all JSON fields in the RadarData struct
 func (rd *RadarData) UpdateJSON() error {
 	// Update EntriesJSON
@@ -105,11 +172,21 @@ func (rd *RadarData) SetDescriptionJS(js string) {
 	rd.DescriptionJS = template.JS(js)
 }
 
+// SetSummary sets the snapshot summary rendered to HTML
+func (rd *RadarData) SetSummary(html string) {
+	rd.Summary = template.HTML(html)
+}
+
 // SetChangesTable sets the HTML table with changes
 func (rd *RadarData) SetChangesTable(html string) {
 	rd.ChangesTable = template.HTML(html)
 }
 
+// SetAdoptionTable sets the HTML table with rings of technologies in the merged radars
+func (rd *RadarData) SetAdoptionTable(html string) {
+	rd.AdoptionTable = template.HTML(html)
+}
+
 // SetEmbeddedLibs sets the embedded JavaScript libraries
 func (rd *RadarData) SetEmbeddedLibs(d3JS, radarJS string) {
 	rd.D3JS = template.JS(d3JS)
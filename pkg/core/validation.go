@@ -0,0 +1,111 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Position is a location in a source file (1-based, zero if unknown)
+type Position struct {
+	// File is set when the location is not in the snapshot file itself,
+	// e.g. in a technology file of a snapshot directory
+	File   string
+	Line   int
+	Column int
+}
+
+// Severity levels of validation problems
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// Rule IDs of validation problems
+const (
+	RuleSyntax          = "syntax"
+	RuleEmpty           = "empty"
+	RuleMissingField    = "missing-field"
+	RuleInvalidRing     = "invalid-ring"
+	RuleInvalidQuadrant = "invalid-quadrant"
+	RuleMaxAge          = "max-age"
+	RuleDuplicate       = "duplicate"
+	RulePossibleTypo    = "possible-typo"
+	RuleUnknownField    = "unknown-field"
+	RuleInvalidDate     = "invalid-date"
+	RuleInvalidRevision = "invalid-revision"
+)
+
+// ValidationError represents a single problem found in a technologies file
+type ValidationError struct {
+	File       string `json:"file"`
+	Technology string `json:"technology,omitempty"`
+	Field      string `json:"field,omitempty"`
+	Message    string `json:"message"`
+	Rule       string `json:"rule"`
+	Severity   string `json:"severity"`
+	Line       int    `json:"line,omitempty"`
+	Column     int    `json:"column,omitempty"`
+}
+
+// Error implements the error interface
+func (e ValidationError) Error() string {
+	return e.Message
+}
+
+// Location returns the problem location in "file:line:col" format.
+// Line and column are omitted when unknown.
+func (e ValidationError) Location() string {
+	switch {
+	case e.Line > 0 && e.Column > 0:
+		return fmt.Sprintf("%s:%d:%d", e.File, e.Line, e.Column)
+	case e.Line > 0:
+		return fmt.Sprintf("%s:%d", e.File, e.Line)
+	default:
+		return e.File
+	}
+}
+
+// IsWarning returns true if the problem doesn't fail validation
+func (e ValidationError) IsWarning() bool {
+	return e.Severity == SeverityWarning
+}
+
+// String returns the problem in "file:line:col: message" format
+// ("file:line:col: warning: message" for warnings), recognised by editors and CI annotations
+func (e ValidationError) String() string {
+	if e.IsWarning() {
+		return e.Location() + ": warning: " + e.Message
+	}
+	return e.Location() + ": " + e.Message
+}
+
+// ValidationErrors is a list of problems found during validation
+type ValidationErrors []ValidationError
+
+// Error implements the error interface, joining all messages
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// First returns the first problem as an error, or nil if there are no problems
+func (e ValidationErrors) First() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e[0]
+}
+
+// ErrorCount returns the number of problems that fail validation
+func (e ValidationErrors) ErrorCount() int {
+	count := 0
+	for _, err := range e {
+		if !err.IsWarning() {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,177 @@
+package core
+
+import (
+	"cmp"
+	"fmt"
+	"path/filepath"
+)
+
+// WorkspaceFileName is the name of the workspace file searched in the current directory
+const WorkspaceFileName = "terago.yaml"
+
+// Workspace lists several radars built together, e.g. a company radar and team radars.
+type Workspace struct {
+	// Title of the combined index page
+	Title  string           `yaml:"title,omitempty"`
+	Radars []WorkspaceRadar `yaml:"radars"`
+	// Optional radar merged from the latest snapshots of the radars
+	Aggregate *WorkspaceAggregate `yaml:"aggregate,omitempty"`
+}
+
+// WorkspaceRadar is a radar of a workspace. Input, meta and template paths are relative
+// to the workspace file, the output path is relative to the output directory.
+type WorkspaceRadar struct {
+	Name  string `yaml:"name"`
+	Input string `yaml:"input"`
+	// Meta defaults to meta.yaml in the input directory
+	Meta string `yaml:"meta,omitempty"`
+	// Template defaults to the template of the generate command
+	Template string `yaml:"template,omitempty"`
+	// Output defaults to the name of the radar
+	Output string `yaml:"output,omitempty"`
+}
+
+// Strategies of resolving different rings of a technology in an aggregated radar
+const (
+	// AggregateConservative uses the outermost ring (default)
+	AggregateConservative = "conservative"
+	// AggregateMajority uses the ring of most radars, the outermost one on a tie
+	AggregateMajority = "majority"
+	// AggregateOwner uses the ring of the owner radar, the outermost one without it
+	AggregateOwner = "owner"
+)
+
+// DefaultAggregateName is the name of an aggregated radar without a name
+const DefaultAggregateName = "aggregate"
+
+// WorkspaceAggregate is a radar merged from the latest snapshots of workspace radars.
+type WorkspaceAggregate struct {
+	Name string `yaml:"name,omitempty"`
+	// Title defaults to the title from meta, or to the name without meta
+	Title string `yaml:"title,omitempty"`
+	// Radars to merge, defaults to all radars of the workspace
+	Radars []string `yaml:"radars,omitempty"`
+	// Strategy is conservative, majority or owner
+	Strategy string `yaml:"strategy,omitempty"`
+	// Owner is the radar whose ring is used with the owner strategy
+	Owner string `yaml:"owner,omitempty"`
+	// Meta defaults to the meta of the first merged radar
+	Meta     string `yaml:"meta,omitempty"`
+	Template string `yaml:"template,omitempty"`
+	// Output defaults to the name
+	Output string `yaml:"output,omitempty"`
+}
+
+// AggregateName returns the name of the aggregated radar
+func (a *WorkspaceAggregate) AggregateName() string {
+	return cmp.Or(a.Name, DefaultAggregateName)
+}
+
+// OutputPath returns the sub-path of the output directory the aggregated radar is generated to
+func (a *WorkspaceAggregate) OutputPath() string {
+	return cmp.Or(a.Output, a.AggregateName())
+}
+
+// AggregateStrategy returns the strategy of resolving different rings
+func (a *WorkspaceAggregate) AggregateStrategy() string {
+	return cmp.Or(a.Strategy, AggregateConservative)
+}
+
+// MergedRadars returns the radars of the workspace merged into the aggregated radar
+func (w *Workspace) MergedRadars() []WorkspaceRadar {
+	if w.Aggregate == nil || len(w.Aggregate.Radars) == 0 {
+		return w.Radars
+	}
+	var radars []WorkspaceRadar
+	for _, name := range w.Aggregate.Radars {
+		for _, r := range w.Radars {
+			if r.Name == name {
+				radars = append(radars, r)
+			}
+		}
+	}
+	return radars
+}
+
+// OutputPath returns the sub-path of the output directory the radar is generated to
+func (r *WorkspaceRadar) OutputPath() string {
+	return cmp.Or(r.Output, r.Name)
+}
+
+// Validate checks that the workspace has radars with unique names and output paths
+// inside the output directory.
+func (w *Workspace) Validate() error {
+	if len(w.Radars) == 0 {
+		return fmt.Errorf("workspace has no radars")
+	}
+
+	names := make(Set[string])
+	outputs := make(Set[string])
+	for i, r := range w.Radars {
+		if r.Name == "" {
+			return fmt.Errorf("radar #%d: name is required", i+1)
+		}
+		if _, exists := names[r.Name]; exists {
+			return fmt.Errorf("radar '%s': duplicate name", r.Name)
+		}
+		names[r.Name] = struct{}{}
+
+		if r.Input == "" {
+			return fmt.Errorf("radar '%s': input is required", r.Name)
+		}
+
+		output := filepath.Clean(r.OutputPath())
+		if !filepath.IsLocal(output) {
+			return fmt.Errorf("radar '%s': output '%s' must be a relative path inside the output directory", r.Name, r.OutputPath())
+		}
+		if _, exists := outputs[output]; exists {
+			return fmt.Errorf("radar '%s': output '%s' is used by another radar", r.Name, r.OutputPath())
+		}
+		outputs[output] = struct{}{}
+	}
+
+	if w.Aggregate != nil {
+		return w.validateAggregate(names, outputs)
+	}
+	return nil
+}
+
+// validateAggregate checks the aggregated radar against the names and outputs of the radars.
+func (w *Workspace) validateAggregate(names, outputs Set[string]) error {
+	a := w.Aggregate
+	if _, exists := names[a.AggregateName()]; exists {
+		return fmt.Errorf("aggregate '%s': name is used by a radar", a.AggregateName())
+	}
+	for _, name := range a.Radars {
+		if _, exists := names[name]; !exists {
+			return fmt.Errorf("aggregate '%s': unknown radar '%s'", a.AggregateName(), name)
+		}
+	}
+
+	switch a.AggregateStrategy() {
+	case AggregateConservative, AggregateMajority:
+	case AggregateOwner:
+		if a.Owner == "" {
+			return fmt.Errorf("aggregate '%s': owner is required for the owner strategy", a.AggregateName())
+		}
+		owned := false
+		for _, r := range w.MergedRadars() {
+			owned = owned || r.Name == a.Owner
+		}
+		if !owned {
+			return fmt.Errorf("aggregate '%s': owner '%s' is not a merged radar", a.AggregateName(), a.Owner)
+		}
+	default:
+		return fmt.Errorf("aggregate '%s': unknown strategy '%s' (use %s, %s or %s)", a.AggregateName(), a.Strategy,
+			AggregateConservative, AggregateMajority, AggregateOwner)
+	}
+
+	output := filepath.Clean(a.OutputPath())
+	if !filepath.IsLocal(output) {
+		return fmt.Errorf("aggregate '%s': output '%s' must be a relative path inside the output directory", a.AggregateName(), a.OutputPath())
+	}
+	if _, exists := outputs[output]; exists {
+		return fmt.Errorf("aggregate '%s': output '%s' is used by a radar", a.AggregateName(), a.OutputPath())
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWorkspaceValidate(t *testing.T) {
+	radars := []WorkspaceRadar{{Name: "data", Input: "data"}, {Name: "mobile", Input: "mobile"}}
+
+	tests := []struct {
+		name      string
+		radars    []WorkspaceRadar
+		aggregate *WorkspaceAggregate
+		wantErr   string
+	}{
+		{
+			name: "valid",
+			radars: []WorkspaceRadar{
+				{Name: "company", Input: "company"},
+				{Name: "data", Input: "teams/data", Output: "teams/data"},
+			},
+		},
+		{name: "no radars", wantErr: "workspace has no radars"},
+		{name: "missing name", radars: []WorkspaceRadar{{Input: "company"}}, wantErr: "name is required"},
+		{name: "missing input", radars: []WorkspaceRadar{{Name: "company"}}, wantErr: "input is required"},
+		{
+			name:    "duplicate name",
+			radars:  []WorkspaceRadar{{Name: "data", Input: "a"}, {Name: "data", Input: "b"}},
+			wantErr: "duplicate name",
+		},
+		{
+			name:    "duplicate output",
+			radars:  []WorkspaceRadar{{Name: "data", Input: "a"}, {Name: "mobile", Input: "b", Output: "data/"}},
+			wantErr: "used by another radar",
+		},
+		{
+			name:    "output outside the output directory",
+			radars:  []WorkspaceRadar{{Name: "data", Input: "a", Output: "../data"}},
+			wantErr: "must be a relative path",
+		},
+		{
+			name:      "valid aggregate",
+			radars:    radars,
+			aggregate: &WorkspaceAggregate{Strategy: AggregateOwner, Owner: "mobile"},
+		},
+		{
+			name:      "aggregate name of a radar",
+			radars:    radars,
+			aggregate: &WorkspaceAggregate{Name: "data"},
+			wantErr:   "name is used by a radar",
+		},
+		{
+			name:      "unknown merged radar",
+			radars:    radars,
+			aggregate: &WorkspaceAggregate{Radars: []string{"web"}},
+			wantErr:   "unknown radar 'web'",
+		},
+		{
+			name:      "unknown strategy",
+			radars:    radars,
+			aggregate: &WorkspaceAggregate{Strategy: "random"},
+			wantErr:   "unknown strategy",
+		},
+		{
+			name:      "missing owner",
+			radars:    radars,
+			aggregate: &WorkspaceAggregate{Strategy: AggregateOwner},
+			wantErr:   "owner is required",
+		},
+		{
+			name:      "owner not merged",
+			radars:    radars,
+			aggregate: &WorkspaceAggregate{Radars: []string{"data"}, Strategy: AggregateOwner, Owner: "mobile"},
+			wantErr:   "is not a merged radar",
+		},
+		{
+			name:      "aggregate output of a radar",
+			radars:    radars,
+			aggregate: &WorkspaceAggregate{Output: "data"},
+			wantErr:   "is used by a radar",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspace := Workspace{Radars: tt.radars, Aggregate: tt.aggregate}
+			err := workspace.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() returned error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, expected %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -13,3 +13,9 @@ var D3JS string
 
 //go:embed radar.min.js
 var RadarJS string
+
+//go:embed index.html
+var IndexHTML string
+
+//go:embed compare.html
+var CompareHTML string
@@ -2,6 +2,7 @@
 var modal = d3.select("#descriptionModal");
 var modalTitle = d3.select("#modalTitle");
 var modalDescription = d3.select("#modalDescription");
+var modalAdoptions = d3.select("#modalAdoptions");
 var closeBtn = d3.select(".close");
 
 // Create a map for faster lookup by ID
@@ -15,7 +16,17 @@ radarEntries.forEach(function(entry, index) {
 function showModal(entry) {
     if (entry && entry.description) {
         modalTitle.text(entry.label);
-        modalDescription.text(entry.description);
+        // Markdown descriptions are rendered to HTML by the generator
+        if (entry.descriptionHtml) {
+            modalDescription.html(entry.descriptionHtml);
+        } else {
+            modalDescription.text(entry.description);
+        }
+        // Rings in the merged radars of an aggregated radar
+        modalAdoptions.selectAll("li").remove();
+        (entry.adoptions || []).forEach(function(adoption) {
+            modalAdoptions.append("li").text(adoption.radar + ": " + adoption.ring);
+        });
         modal.style("display", "block");
     }
 }
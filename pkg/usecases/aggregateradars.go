@@ -0,0 +1,191 @@
+package usecases
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+// AggregateSource is the latest snapshot of a radar merged into an aggregated radar.
+type AggregateSource struct {
+	// Name of the radar, recorded in the adoptions of its technologies
+	Name string
+	// Meta of the radar, used to resolve ring and quadrant aliases
+	Meta core.Meta
+	File core.TechnologiesFile
+}
+
+// AggregateRadars merges snapshots of several radars into one. Rings and quadrants are
+// mapped by name to the ones of meta. A technology used by several radars gets one ring
+// chosen by the strategy (see core.AggregateConservative and others), its description
+// comes from the radar the ring was taken from. Every technology records its ring in each
+// radar as adoptions. Names are matched case-insensitively; a technology listed twice in
+// one radar is taken from its first entry, as duplicates are reported by validation.
+// Technologies are ordered by their first appearance in the sources, the date of the result
+// is the date of the latest source.
+func AggregateRadars(sources []AggregateSource, meta core.Meta, strategy, owner string) (core.TechnologiesFile, error) {
+	var merged core.TechnologiesFile
+	index := make(map[string]int)
+	// Technologies of each radar by name key, to take descriptions from the chosen radar
+	byRadar := make(map[string]map[string]core.Technology)
+
+	for _, source := range sources {
+		if merged.Date == "" || merged.Before(source.File) {
+			merged.Date = source.File.Date
+			merged.Time = source.File.Time
+		}
+		byRadar[source.Name] = make(map[string]core.Technology)
+
+		for _, tech := range source.File.Technologies {
+			if tech.IsDeleted {
+				continue
+			}
+			ring, err := aggregateRing(tech.Ring, source.Meta, meta)
+			if err != nil {
+				return merged, fmt.Errorf("radar '%s', technology '%s': %v", source.Name, tech.Name, err)
+			}
+			quadrant, err := aggregateQuadrant(tech.Quadrant, source.Meta, meta)
+			if err != nil {
+				return merged, fmt.Errorf("radar '%s', technology '%s': %v", source.Name, tech.Name, err)
+			}
+			key := strings.ToLower(strings.TrimSpace(tech.Name))
+			if _, listed := byRadar[source.Name][key]; listed {
+				continue
+			}
+			tech.Ring, tech.Quadrant = ring, quadrant
+			byRadar[source.Name][key] = tech
+
+			i, exists := index[key]
+			if !exists {
+				i = len(merged.Technologies)
+				index[key] = i
+				merged.Technologies = append(merged.Technologies, core.Technology{
+					Name:        tech.Name,
+					Quadrant:    tech.Quadrant,
+					Description: tech.Description,
+					Info:        tech.Info,
+					Tags:        tech.Tags,
+				})
+			}
+			merged.Technologies[i].Adoptions = append(merged.Technologies[i].Adoptions,
+				core.Adoption{Radar: source.Name, Ring: ring})
+		}
+	}
+
+	for i := range merged.Technologies {
+		tech := &merged.Technologies[i]
+		adoption := resolveAdoption(tech.Adoptions, meta, strategy, owner)
+		tech.Ring = adoption.Ring
+		if source, ok := byRadar[adoption.Radar][strings.ToLower(strings.TrimSpace(tech.Name))]; ok && source.Description != "" {
+			tech.Description = source.Description
+		}
+	}
+
+	return merged, nil
+}
+
+// resolveAdoption chooses the adoption whose ring the aggregated technology gets.
+func resolveAdoption(adoptions []core.Adoption, meta core.Meta, strategy, owner string) core.Adoption {
+	// The outermost ring, the first radar with it on a tie
+	conservative := adoptions[0]
+	for _, a := range adoptions[1:] {
+		if getRingIndex(a.Ring, meta.Rings) > getRingIndex(conservative.Ring, meta.Rings) {
+			conservative = a
+		}
+	}
+
+	switch strategy {
+	case core.AggregateMajority:
+		votes := make(map[string]int)
+		for _, a := range adoptions {
+			votes[a.Ring]++
+		}
+		best := conservative
+		for _, a := range adoptions {
+			if votes[a.Ring] > votes[best.Ring] ||
+				votes[a.Ring] == votes[best.Ring] && getRingIndex(a.Ring, meta.Rings) > getRingIndex(best.Ring, meta.Rings) {
+				best = a
+			}
+		}
+		return best
+	case core.AggregateOwner:
+		for _, a := range adoptions {
+			if a.Radar == owner {
+				return a
+			}
+		}
+	}
+	return conservative
+}
+
+// aggregateRing maps a ring name or alias of a merged radar to the ring name in meta.
+func aggregateRing(ring string, sourceMeta, meta core.Meta) (string, error) {
+	if r, ok := sourceMeta.FindRing(ring); ok {
+		ring = r.Name
+	}
+	for _, r := range meta.Rings {
+		if strings.EqualFold(r.Name, ring) || strings.EqualFold(r.Alias, ring) {
+			return r.Name, nil
+		}
+	}
+	return "", fmt.Errorf("ring '%s' is not in the meta of the aggregated radar", ring)
+}
+
+// aggregateQuadrant maps a quadrant name or alias of a merged radar to the quadrant name in meta.
+func aggregateQuadrant(quadrant string, sourceMeta, meta core.Meta) (string, error) {
+	for _, q := range sourceMeta.Quadrants {
+		if q.Name == quadrant || q.Alias == quadrant {
+			quadrant = q.Name
+			break
+		}
+	}
+	for _, q := range meta.Quadrants {
+		if strings.EqualFold(q.Name, quadrant) || strings.EqualFold(q.Alias, quadrant) {
+			return q.Name, nil
+		}
+	}
+	return "", fmt.Errorf("quadrant '%s' is not in the meta of the aggregated radar", quadrant)
+}
+
+// buildAdoptionTable creates HTML rows with the ring of each technology in each merged radar.
+// Returns the names of the radars in order of appearance and only tbody content.
+func buildAdoptionTable(technologies []core.Technology) ([]string, string) {
+	var radars []string
+	seen := make(map[string]bool)
+	for _, tech := range technologies {
+		for _, a := range tech.Adoptions {
+			if !seen[a.Radar] {
+				seen[a.Radar] = true
+				radars = append(radars, a.Radar)
+			}
+		}
+	}
+	if len(radars) == 0 {
+		return nil, ""
+	}
+
+	var html strings.Builder
+	for _, tech := range technologies {
+		rings := make(map[string]string)
+		for _, a := range tech.Adoptions {
+			rings[a.Radar] = a.Ring
+		}
+
+		html.WriteString("\n\t\t\t\t\t<tr>")
+		html.WriteString("\n\t\t\t\t\t\t<td><strong>" + tech.Name + "</strong></td>")
+		html.WriteString("\n\t\t\t\t\t\t<td>" + tech.Quadrant + "</td>")
+		html.WriteString("\n\t\t\t\t\t\t<td class=\"status-" + strings.ToLower(tech.Ring) + "\">" + tech.Ring + "</td>")
+		for _, radar := range radars {
+			ring := rings[radar]
+			if ring == "" {
+				html.WriteString("\n\t\t\t\t\t\t<td>—</td>")
+				continue
+			}
+			html.WriteString("\n\t\t\t\t\t\t<td class=\"status-" + strings.ToLower(ring) + "\">" + ring + "</td>")
+		}
+		html.WriteString("\n\t\t\t\t\t</tr>")
+	}
+
+	return radars, html.String()
+}
@@ -0,0 +1,108 @@
+package usecases
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+func TestAggregateRadars(t *testing.T) {
+	meta := core.DefaultMeta()
+	source := func(name, date string, techs ...core.Technology) AggregateSource {
+		return AggregateSource{Name: name, Meta: meta, File: core.TechnologiesFile{Date: date, Technologies: techs}}
+	}
+	tech := func(name, ring, description string) core.Technology {
+		return core.Technology{Name: name, Ring: ring, Quadrant: "languages", Description: description}
+	}
+	sources := []AggregateSource{
+		source("data", "20240101", tech("Go", "adopt", "Go at data"), tech("Scala", "Trial", "Scala at data")),
+		// Names are matched case-insensitively
+		source("mobile", "20240301", tech("go", "Hold", "Go at mobile"), tech("Kotlin", "Adopt", "Kotlin")),
+		// A technology listed twice in a radar counts once
+		source("platform", "20240201", tech("Go", "Adopt", "Go at platform"), tech("Go", "Hold", "Go twice"),
+			core.Technology{Name: "Perl", Ring: "Hold", Quadrant: "Languages", IsDeleted: true}),
+	}
+
+	tests := []struct {
+		strategy    string
+		owner       string
+		ring        string
+		description string
+	}{
+		{strategy: core.AggregateConservative, ring: "Hold", description: "Go at mobile"},
+		{strategy: core.AggregateMajority, ring: "Adopt", description: "Go at data"},
+		{strategy: core.AggregateOwner, owner: "platform", ring: "Adopt", description: "Go at platform"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.strategy, func(t *testing.T) {
+			merged, err := AggregateRadars(sources, meta, tt.strategy, tt.owner)
+			if err != nil {
+				t.Fatalf("AggregateRadars failed: %v", err)
+			}
+			if merged.Date != "20240301" {
+				t.Errorf("Expected date of the latest snapshot, got %s", merged.Date)
+			}
+
+			var names []string
+			for _, tech := range merged.Technologies {
+				names = append(names, tech.Name)
+			}
+			if !reflect.DeepEqual(names, []string{"Go", "Scala", "Kotlin"}) {
+				t.Fatalf("Unexpected technologies: %v", names)
+			}
+
+			goTech := merged.Technologies[0]
+			if goTech.Ring != tt.ring || goTech.Description != tt.description || goTech.Quadrant != "Languages" {
+				t.Errorf("Unexpected Go: ring %s, description %q, quadrant %s", goTech.Ring, goTech.Description, goTech.Quadrant)
+			}
+			expected := []core.Adoption{{Radar: "data", Ring: "Adopt"}, {Radar: "mobile", Ring: "Hold"}, {Radar: "platform", Ring: "Adopt"}}
+			if !reflect.DeepEqual(goTech.Adoptions, expected) {
+				t.Errorf("Unexpected adoptions: %+v", goTech.Adoptions)
+			}
+		})
+	}
+
+	t.Run("owner without the technology", func(t *testing.T) {
+		merged, err := AggregateRadars(sources, meta, core.AggregateOwner, "platform")
+		if err != nil {
+			t.Fatalf("AggregateRadars failed: %v", err)
+		}
+		if merged.Technologies[2].Ring != "Adopt" {
+			t.Errorf("Expected ring of the only radar, got %s", merged.Technologies[2].Ring)
+		}
+	})
+
+	t.Run("unknown ring", func(t *testing.T) {
+		_, err := AggregateRadars([]AggregateSource{source("data", "20240101", tech("Go", "Never", "Go"))}, meta, core.AggregateConservative, "")
+		if err == nil || !strings.Contains(err.Error(), "ring 'Never'") {
+			t.Errorf("Expected unknown ring error, got %v", err)
+		}
+	})
+}
+
+func TestBuildAdoptionTable(t *testing.T) {
+	if radars, html := buildAdoptionTable([]core.Technology{{Name: "Go", Ring: "Adopt"}}); radars != nil || html != "" {
+		t.Errorf("Expected no table without adoptions, got %v %q", radars, html)
+	}
+
+	technologies := []core.Technology{
+		{Name: "Go", Ring: "Hold", Quadrant: "Languages", Adoptions: []core.Adoption{{Radar: "data", Ring: "Adopt"}, {Radar: "mobile", Ring: "Hold"}}},
+		{Name: "Kotlin", Ring: "Adopt", Quadrant: "Languages", Adoptions: []core.Adoption{{Radar: "mobile", Ring: "Adopt"}}},
+	}
+	radars, html := buildAdoptionTable(technologies)
+	if !reflect.DeepEqual(radars, []string{"data", "mobile"}) {
+		t.Errorf("Unexpected radars: %v", radars)
+	}
+	for _, expected := range []string{
+		`<td class="status-hold">Hold</td>`,
+		`<td class="status-adopt">Adopt</td>`,
+		`<td>—</td>`,
+	} {
+		if !strings.Contains(html, expected) {
+			t.Errorf("Expected %q in the table:\n%s", expected, html)
+		}
+	}
+}
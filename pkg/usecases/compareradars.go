@@ -0,0 +1,163 @@
+package usecases
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ekalinin/terago/pkg/core"
+	"github.com/ekalinin/terago/pkg/radar"
+)
+
+// ComparedSnapshot is a snapshot of a radar on one side of a comparison.
+type ComparedSnapshot struct {
+	// Name of the radar on the page, shown with the snapshot date
+	Name string
+	File core.TechnologiesFile
+	Meta core.Meta
+}
+
+// CompareRadars represents the generation of a page with two radars side by side:
+// two team radars or two snapshots of one radar.
+type CompareRadars struct {
+	OutputFile string
+	// TemplatePath is the radar template of both sides (embedded template if empty)
+	TemplatePath string
+	Left         ComparedSnapshot
+	Right        ComparedSnapshot
+	IncludeLinks bool
+	EmbedLibs    bool
+}
+
+// Do renders both snapshots with the radar template and writes the comparison page
+// with a table of technologies whose rings differ.
+func (c *CompareRadars) Do() error {
+	radarTmpl, err := parseRadarTemplate(c.TemplatePath)
+	if err != nil {
+		return err
+	}
+
+	data := core.CompareData{
+		Version:     core.Version,
+		GeneratedAt: time.Now().Format("2006-01-02 15:04:05"),
+	}
+	for _, side := range []struct {
+		snapshot ComparedSnapshot
+		compared *core.ComparedRadar
+	}{{c.Left, &data.Left}, {c.Right, &data.Right}} {
+		radarData, err := newRadarData(side.snapshot.File, side.snapshot.Meta, c.IncludeLinks, c.EmbedLibs)
+		if err != nil {
+			return err
+		}
+		var page bytes.Buffer
+		if err := radarTmpl.Execute(&page, radarData); err != nil {
+			return err
+		}
+		*side.compared = core.ComparedRadar{Name: side.snapshot.Name, Date: radarData.Date, Page: page.String()}
+	}
+
+	data.Title = fmt.Sprintf("%s %s vs %s %s", data.Left.Name, data.Left.Date, data.Right.Name, data.Right.Date)
+	if data.Left.Name == data.Right.Name {
+		data.Title = fmt.Sprintf("%s: %s vs %s", data.Left.Name, data.Left.Date, data.Right.Date)
+	}
+
+	differences := compareTechnologies(c.Left, c.Right)
+	data.SetDisagreementTable(buildDisagreementTable(differences))
+
+	tmpl, err := template.New("compare").Parse(radar.CompareHTML)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.OutputFile), 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(c.OutputFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return tmpl.Execute(f, data)
+}
+
+// compareTechnologies marks the technologies of the right snapshot compared to the left one
+// like changes between periods: technologies only on the right are new, technologies only
+// on the left are deleted and technologies in different rings are moved.
+// Rings are compared by name, so aliases of different metas match.
+func compareTechnologies(left, right ComparedSnapshot) []core.Technology {
+	previous := comparedTechnologies(left)
+	current := comparedTechnologies(right)
+	markChanges(&current, previous)
+	return current
+}
+
+// comparedTechnologies returns the technologies of a snapshot without deleted ones,
+// with ring names from meta and without change marks.
+func comparedTechnologies(side ComparedSnapshot) []core.Technology {
+	var technologies []core.Technology
+	for _, tech := range side.File.Technologies {
+		if tech.IsDeleted {
+			continue
+		}
+		if r, ok := side.Meta.FindRing(tech.Ring); ok {
+			tech.Ring = r.Name
+		}
+		tech.IsNew, tech.IsMoved, tech.PreviousRing = false, false, ""
+		technologies = append(technologies, tech)
+	}
+	return technologies
+}
+
+// buildDisagreementTable creates HTML rows for technologies in different rings or only on one side.
+// Returns only tbody content (without table structure and headers).
+func buildDisagreementTable(technologies []core.Technology) string {
+	var html strings.Builder
+
+	for _, tech := range technologies {
+		var left, right string
+		switch {
+		case tech.IsDeleted:
+			left = tech.Ring
+		case tech.IsNew:
+			right = tech.Ring
+		case tech.IsMoved:
+			left, right = tech.PreviousRing, tech.Ring
+		default:
+			continue
+		}
+
+		html.WriteString("\n\t\t\t\t<tr>")
+		html.WriteString("\n\t\t\t\t\t<td><strong>" + tech.Name + "</strong></td>")
+		html.WriteString("\n\t\t\t\t\t<td>" + tech.Quadrant + "</td>")
+		for _, ring := range []string{left, right} {
+			if ring == "" {
+				html.WriteString("\n\t\t\t\t\t<td>—</td>")
+				continue
+			}
+			html.WriteString("\n\t\t\t\t\t<td class=\"status-" + strings.ToLower(ring) + "\">" + ring + "</td>")
+		}
+		html.WriteString("\n\t\t\t\t</tr>")
+	}
+
+	return html.String()
+}
+
+// FindSnapshot returns the snapshot with the date (see SnapshotDate), or the latest one if date is empty.
+func FindSnapshot(files []core.TechnologiesFile, date string) (core.TechnologiesFile, error) {
+	if len(files) == 0 {
+		return core.TechnologiesFile{}, fmt.Errorf("no snapshots found")
+	}
+	if date == "" {
+		return files[len(files)-1], nil
+	}
+	for _, file := range files {
+		if file.Date == date {
+			return file, nil
+		}
+	}
+	return core.TechnologiesFile{}, fmt.Errorf("snapshot '%s' not found", date)
+}
@@ -0,0 +1,68 @@
+package usecases
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+func TestCompareRadars(t *testing.T) {
+	meta := core.DefaultMeta()
+	left := ComparedSnapshot{Name: "Data", Meta: meta, File: core.TechnologiesFile{Date: "20240101", Technologies: []core.Technology{
+		{Name: "Go", Ring: "adopt", Quadrant: "Languages", Description: "Go"},
+		{Name: "Scala", Ring: "Trial", Quadrant: "Languages", Description: "Scala"},
+		{Name: "Perl", Ring: "Hold", Quadrant: "Languages", Description: "Perl", IsDeleted: true},
+	}}}
+	right := ComparedSnapshot{Name: "Mobile", Meta: meta, File: core.TechnologiesFile{Date: "20240301", Technologies: []core.Technology{
+		{Name: "Go", Ring: "Adopt", Quadrant: "Languages", Description: "Go", IsNew: true},
+		{Name: "Scala", Ring: "Hold", Quadrant: "Languages", Description: "Scala"},
+		{Name: "Kotlin", Ring: "Adopt", Quadrant: "Languages", Description: "Kotlin"},
+	}}}
+
+	outputFile := filepath.Join(t.TempDir(), "public", "compare.html")
+	compare := CompareRadars{OutputFile: outputFile, Left: left, Right: right}
+	if err := compare.Do(); err != nil {
+		t.Fatalf("CompareRadars failed: %v", err)
+	}
+
+	content, err := os.ReadFile(outputFile)
+	if err != nil {
+		t.Fatalf("Failed to read output file: %v", err)
+	}
+	page := string(content)
+	for _, expected := range []string{
+		"<title>Data 2024-01-01 vs Mobile 2024-03-01</title>",
+		"<th>Data (2024-01-01)</th>",
+		"srcdoc=\"&lt;!DOCTYPE html&gt;",
+		"<td><strong>Scala</strong></td>",
+		`<td class="status-trial">Trial</td>`,
+		`<td class="status-hold">Hold</td>`,
+		"<td><strong>Kotlin</strong></td>",
+	} {
+		if !strings.Contains(page, expected) {
+			t.Errorf("Expected %q in the comparison page", expected)
+		}
+	}
+	for _, unexpected := range []string{"<td><strong>Go</strong></td>", "<td><strong>Perl</strong></td>"} {
+		if strings.Contains(page, unexpected) {
+			t.Errorf("Unexpected %q in the comparison page", unexpected)
+		}
+	}
+}
+
+func TestFindSnapshot(t *testing.T) {
+	files := []core.TechnologiesFile{{Date: "20240101"}, {Date: "20240201"}}
+
+	if file, err := FindSnapshot(files, ""); err != nil || file.Date != "20240201" {
+		t.Errorf("Expected latest snapshot, got %v, %v", file.Date, err)
+	}
+	if file, err := FindSnapshot(files, "20240101"); err != nil || file.Date != "20240101" {
+		t.Errorf("Expected snapshot 20240101, got %v, %v", file.Date, err)
+	}
+	if _, err := FindSnapshot(files, "20240301"); err == nil {
+		t.Error("Expected error for unknown snapshot")
+	}
+}
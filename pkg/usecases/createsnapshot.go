@@ -0,0 +1,238 @@
+package usecases
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+// CreateSnapshot represents the creation of a new period snapshot from the latest one.
+type CreateSnapshot struct {
+	InputDir string
+	Meta     core.Meta
+	Date     string
+	// PersistentOnly carries over only entries whose rings have persist: true in meta
+	PersistentOnly bool
+}
+
+// snapshotInfoKeys are the top-level keys with the title, summary, publication date and
+// draft flag of a snapshot. They describe the snapshot itself and are not carried over.
+var snapshotInfoKeys = []string{"title", "summary", "publishedAt", "draft"}
+
+// Do copies the newest snapshot found by GetRadarFiles to <Date>.<ext>, keeping its format,
+// or to the <Date> directory for snapshots in the directory layout.
+// The file is copied as is (comments and formatting are kept) except for the title, summary,
+// publication date and draft flag of the latest snapshot, which are removed. If PersistentOnly
+// is true, entries of non-persistent rings are removed too. The date must be after the date
+// of the latest snapshot. An existing file is never overwritten. Returns the path of the new snapshot.
+func (s *CreateSnapshot) Do() (string, error) {
+	datePattern, err := regexp.Compile(s.Meta.FileNamePattern)
+	if err != nil {
+		return "", fmt.Errorf("invalid fileNamePattern '%s': %v", s.Meta.FileNamePattern, err)
+	}
+
+	files, err := GetRadarFiles(s.InputDir, s.Meta)
+	if err != nil {
+		return "", err
+	}
+	if len(files) == 0 {
+		return "", fmt.Errorf("no radar files found in %s", s.InputDir)
+	}
+	latest := files[len(files)-1]
+
+	if info, err := os.Stat(latest); err == nil && info.IsDir() {
+		if !isSnapshotDir(s.Date, datePattern) {
+			return "", fmt.Errorf("directory name %s does not match fileNamePattern '%s'", s.Date, s.Meta.FileNamePattern)
+		}
+		if err := s.checkAfter(latest, s.Date+".yaml"); err != nil {
+			return "", err
+		}
+		return s.copyDir(latest)
+	}
+
+	newFile := filepath.Join(s.InputDir, s.Date+filepath.Ext(latest))
+	if !datePattern.MatchString(filepath.Base(newFile)) {
+		return "", fmt.Errorf("file name %s does not match fileNamePattern '%s'", filepath.Base(newFile), s.Meta.FileNamePattern)
+	}
+	if err := s.checkAfter(latest, filepath.Base(newFile)); err != nil {
+		return "", err
+	}
+
+	data, err := os.ReadFile(latest)
+	if err != nil {
+		return "", fmt.Errorf("error reading file: %v", err)
+	}
+
+	data, err = s.prepareCopy(latest, data)
+	if err != nil {
+		return "", fmt.Errorf("error processing file %s: %v", latest, err)
+	}
+
+	if err := writeNewFile(newFile, data, false); err != nil {
+		return "", err
+	}
+
+	return newFile, nil
+}
+
+// checkAfter returns an error if the new snapshot with the file name is not after the latest snapshot.
+func (s *CreateSnapshot) checkAfter(latest, newName string) error {
+	latestSnapshot, err := snapshotOf(latest, s.Meta)
+	if err != nil {
+		return err
+	}
+	newSnapshot := core.TechnologiesFile{Date: s.Date}
+	if newSnapshot.Time, err = s.Meta.SnapshotTime(newName, s.Date); err != nil {
+		return err
+	}
+	if !latestSnapshot.Before(newSnapshot) {
+		return fmt.Errorf("date %s is not after the latest snapshot %s", s.Date, filepath.Base(latest))
+	}
+	return nil
+}
+
+// prepareCopy returns the content of the new snapshot: the latest file without snapshot info
+// and, if PersistentOnly is true, without entries of non-persistent rings.
+// Only the removed lines change, the rest of the content is kept as is. The content
+// is returned unchanged when there is nothing to remove, otherwise only YAML files
+// can be processed.
+func (s *CreateSnapshot) prepareCopy(latest string, data []byte) ([]byte, error) {
+	decoder, ok := technologiesDecoder(latest)
+	if !ok {
+		return data, nil
+	}
+	doc, err := decoder(data)
+	if err != nil {
+		return nil, err
+	}
+	root := documentRoot(doc)
+
+	hasInfo := false
+	for _, key := range snapshotInfoKeys {
+		hasInfo = hasInfo || mappingValue(root, key) != nil
+	}
+	if !hasInfo && !s.PersistentOnly {
+		return data, nil
+	}
+	if err := requireYAMLFile(latest); err != nil {
+		return nil, fmt.Errorf("can't remove the snapshot info or entries of non-persistent rings: %v", err)
+	}
+
+	var removed []*yaml.Node
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if slices.Contains(snapshotInfoKeys, root.Content[i].Value) {
+			removed = append(removed, root.Content[i])
+		}
+	}
+	if s.PersistentOnly {
+		items, err := nonPersistentTechnologies(doc, s.Meta)
+		if err != nil {
+			return nil, err
+		}
+		removed = append(removed, items...)
+	}
+
+	// Entries of flow collections share lines, so the file is written from the node tree
+	if !isBlockStyle(root) || !isBlockStyle(mappingValue(root, "technologies")) {
+		removeMappingKeys(root, snapshotInfoKeys)
+		if s.PersistentOnly {
+			if err := filterPersistentTechnologies(doc, s.Meta); err != nil {
+				return nil, err
+			}
+		}
+		return marshalYAML(doc)
+	}
+	return removeEntries(data, removed), nil
+}
+
+// nonPersistentTechnologies returns the technologies whose ring is not persistent.
+func nonPersistentTechnologies(doc *yaml.Node, meta core.Meta) ([]*yaml.Node, error) {
+	technologies := mappingValue(documentRoot(doc), "technologies")
+	if technologies == nil || technologies.Kind != yaml.SequenceNode {
+		return nil, fmt.Errorf("no technologies list found")
+	}
+
+	var items []*yaml.Node
+	for _, item := range technologies.Content {
+		ring := mappingValue(item, "ring")
+		if ring == nil || !meta.IsPersistentRing(strings.TrimSpace(ring.Value)) {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+// filterPersistentTechnologies removes technologies whose ring is not persistent,
+// keeping comments and key order of the remaining entries.
+func filterPersistentTechnologies(doc *yaml.Node, meta core.Meta) error {
+	items, err := nonPersistentTechnologies(doc, meta)
+	if err != nil {
+		return err
+	}
+	technologies := mappingValue(documentRoot(doc), "technologies")
+	technologies.Content = slices.DeleteFunc(technologies.Content, func(item *yaml.Node) bool {
+		return slices.Contains(items, item)
+	})
+	return nil
+}
+
+// copyDir copies a snapshot directory to the <Date> directory without its index file,
+// leaving out technologies of non-persistent rings if PersistentOnly is true.
+// The new directory is removed if the copy fails.
+func (s *CreateSnapshot) copyDir(latest string) (string, error) {
+	newDir := filepath.Join(s.InputDir, s.Date)
+	if err := os.Mkdir(newDir, 0755); err != nil {
+		if os.IsExist(err) {
+			return "", fmt.Errorf("snapshot %s already exists", newDir)
+		}
+		return "", err
+	}
+
+	err := filepath.WalkDir(latest, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(latest, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(newDir, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+		// The title, summary, publication date and draft flag of the latest snapshot
+		if rel == snapshotIndexFile {
+			return nil
+		}
+
+		if s.PersistentOnly && strings.EqualFold(filepath.Ext(path), technologyMarkdownExt) && filepath.Dir(rel) != "." {
+			tech, _, err := parseTechnologyMarkdown(path, filepath.Base(filepath.Dir(path)))
+			if err != nil {
+				return err
+			}
+			if !s.Meta.IsPersistentRing(strings.TrimSpace(tech.Ring)) {
+				return nil
+			}
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("error reading file: %v", err)
+		}
+		return os.WriteFile(target, data, 0644)
+	})
+	if err != nil {
+		// Don't leave a partial snapshot behind
+		os.RemoveAll(newDir)
+		return "", err
+	}
+
+	return newDir, nil
+}
@@ -0,0 +1,268 @@
+package usecases
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+const snapshotContent = `# Radar for the first quarter
+technologies:
+  # Our main language
+  - name: "Go"
+    ring: "Adopt"
+    quadrant: "Languages"
+    description: "Programming language"
+  - name: "Rust"
+    ring: "Assess"
+    quadrant: "Languages"
+    description: "Systems programming language"
+`
+
+func TestCreateSnapshot(t *testing.T) {
+	t.Run("copies latest file as is", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(tmpDir, "20240101.yaml"), []byte("technologies: []\n"), 0644); err != nil {
+			t.Fatalf("Failed to create test file: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(tmpDir, "20240201.yaml"), []byte(snapshotContent), 0644); err != nil {
+			t.Fatalf("Failed to create test file: %v", err)
+		}
+
+		snapshot := CreateSnapshot{InputDir: tmpDir, Meta: core.DefaultMeta(), Date: "20240401"}
+		newFile, err := snapshot.Do()
+		if err != nil {
+			t.Fatalf("CreateSnapshot failed: %v", err)
+		}
+
+		if newFile != filepath.Join(tmpDir, "20240401.yaml") {
+			t.Errorf("Unexpected new file: %s", newFile)
+		}
+
+		data, err := os.ReadFile(newFile)
+		if err != nil {
+			t.Fatalf("Failed to read new file: %v", err)
+		}
+		if string(data) != snapshotContent {
+			t.Errorf("Expected exact copy of the latest file, got:\n%s", data)
+		}
+
+		// Should refuse to overwrite
+		if _, err := snapshot.Do(); err == nil {
+			t.Error("Expected error when snapshot already exists, got nil")
+		}
+	})
+
+	t.Run("persistent rings only", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(tmpDir, "20240101.yaml"), []byte(snapshotContent), 0644); err != nil {
+			t.Fatalf("Failed to create test file: %v", err)
+		}
+
+		rings := []core.Ring{
+			{Name: "Adopt", Alias: "adopt", Persist: true},
+			{Name: "Assess", Alias: "assess"},
+		}
+		meta := core.NewMeta("", "", nil, rings)
+
+		snapshot := CreateSnapshot{InputDir: tmpDir, Meta: meta, Date: "20240401", PersistentOnly: true}
+		newFile, err := snapshot.Do()
+		if err != nil {
+			t.Fatalf("CreateSnapshot failed: %v", err)
+		}
+
+		data, err := os.ReadFile(newFile)
+		if err != nil {
+			t.Fatalf("Failed to read new file: %v", err)
+		}
+		content := string(data)
+		if !strings.Contains(content, "Go") || strings.Contains(content, "Rust") {
+			t.Errorf("Expected only Go to be carried over, got:\n%s", content)
+		}
+		if !strings.Contains(content, "# Our main language") {
+			t.Errorf("Expected comments to be kept, got:\n%s", content)
+		}
+	})
+
+	t.Run("keeps formatting of the remaining lines", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		content := `title: Consolidation
+summary: |
+  We moved to one platform.
+
+  Details follow.
+technologies:
+# Our main language
+- name: Go
+  ring: Adopt
+  quadrant: Languages
+  description: Programming language
+
+# Systems
+- name: Rust
+  ring: Assess
+  quadrant: Languages
+  description: >
+    Systems programming
+    language
+- {name: Java, ring: Adopt, quadrant: Languages, description: JVM}
+`
+		if err := os.WriteFile(filepath.Join(tmpDir, "20240101.yaml"), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create test file: %v", err)
+		}
+		rings := []core.Ring{
+			{Name: "Adopt", Alias: "adopt", Persist: true},
+			{Name: "Assess", Alias: "assess"},
+		}
+		snapshot := CreateSnapshot{InputDir: tmpDir, Meta: core.NewMeta("", "", nil, rings), Date: "20240401", PersistentOnly: true}
+		newFile, err := snapshot.Do()
+		if err != nil {
+			t.Fatalf("CreateSnapshot failed: %v", err)
+		}
+
+		data, err := os.ReadFile(newFile)
+		if err != nil {
+			t.Fatalf("Failed to read new file: %v", err)
+		}
+		expected := `technologies:
+# Our main language
+- name: Go
+  ring: Adopt
+  quadrant: Languages
+  description: Programming language
+- {name: Java, ring: Adopt, quadrant: Languages, description: JVM}
+`
+		if string(data) != expected {
+			t.Errorf("Expected only the removed lines to change, got:\n%s", data)
+		}
+	})
+
+	t.Run("no files", func(t *testing.T) {
+		snapshot := CreateSnapshot{InputDir: t.TempDir(), Meta: core.DefaultMeta(), Date: "20240401"}
+		if _, err := snapshot.Do(); err == nil {
+			t.Error("Expected error when there are no snapshots, got nil")
+		}
+	})
+
+	t.Run("name does not match pattern", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(tmpDir, "20240101.yaml"), []byte(snapshotContent), 0644); err != nil {
+			t.Fatalf("Failed to create test file: %v", err)
+		}
+
+		snapshot := CreateSnapshot{InputDir: tmpDir, Meta: core.DefaultMeta(), Date: "2024-04-01"}
+		if _, err := snapshot.Do(); err == nil {
+			t.Error("Expected error for file name not matching pattern, got nil")
+		}
+	})
+
+	t.Run("keeps file format", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		content := `{"technologies": [{"name": "Go", "ring": "Adopt", "quadrant": "Languages", "description": "Go"}]}`
+		if err := os.WriteFile(filepath.Join(tmpDir, "20240101.json"), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create test file: %v", err)
+		}
+
+		snapshot := CreateSnapshot{InputDir: tmpDir, Meta: core.DefaultMeta(), Date: "20240401"}
+		newFile, err := snapshot.Do()
+		if err != nil {
+			t.Fatalf("CreateSnapshot failed: %v", err)
+		}
+		if newFile != filepath.Join(tmpDir, "20240401.json") {
+			t.Errorf("Expected JSON snapshot, got %s", newFile)
+		}
+
+		snapshot.Date = "20240501"
+		snapshot.PersistentOnly = true
+		if _, err := snapshot.Do(); err == nil || !strings.Contains(err.Error(), "is a JSON file") {
+			t.Errorf("Expected error naming the JSON format, got %v", err)
+		}
+	})
+
+	t.Run("clears snapshot info", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		content := "title: Consolidation\nsummary: We moved to one platform\npublishedAt: 2024-01-05\ndraft: true\n" + snapshotContent
+		if err := os.WriteFile(filepath.Join(tmpDir, "20240101.yaml"), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create test file: %v", err)
+		}
+
+		snapshot := CreateSnapshot{InputDir: tmpDir, Meta: core.DefaultMeta(), Date: "20240401"}
+		newFile, err := snapshot.Do()
+		if err != nil {
+			t.Fatalf("CreateSnapshot failed: %v", err)
+		}
+
+		technologiesFile, _, err := parseTechnologiesFile(newFile)
+		if err != nil {
+			t.Fatalf("Failed to parse new file: %v", err)
+		}
+		if technologiesFile.Title != "" || technologiesFile.Summary != "" || technologiesFile.PublishedAt != "" || technologiesFile.Draft {
+			t.Errorf("Expected snapshot info to be cleared, got %+v", technologiesFile)
+		}
+		if len(technologiesFile.Technologies) != 2 {
+			t.Errorf("Expected 2 technologies, got %d", len(technologiesFile.Technologies))
+		}
+	})
+
+	t.Run("clears snapshot directory index", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		writeSnapshotDir(t, filepath.Join(tmpDir, "20240101"), map[string]string{
+			"index.md":        "---\ntitle: Consolidation\ndraft: true\n---\n\nSummary\n",
+			"languages/go.md": "---\nring: adopt\n---\n",
+		})
+
+		snapshot := CreateSnapshot{InputDir: tmpDir, Meta: core.DefaultMeta(), Date: "20240401"}
+		newDir, err := snapshot.Do()
+		if err != nil {
+			t.Fatalf("CreateSnapshot failed: %v", err)
+		}
+		if _, err := os.Stat(filepath.Join(newDir, "index.md")); !os.IsNotExist(err) {
+			t.Error("index.md should not be copied")
+		}
+		if _, err := os.Stat(filepath.Join(newDir, "languages", "go.md")); err != nil {
+			t.Errorf("Technology should be copied: %v", err)
+		}
+	})
+
+	t.Run("removes partial snapshot directory", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		writeSnapshotDir(t, filepath.Join(tmpDir, "20240101"), map[string]string{
+			"languages/go.md":   "---\nring: adopt\n---\n",
+			"languages/rust.md": "---\nring: [\n---\n",
+		})
+
+		snapshot := CreateSnapshot{InputDir: tmpDir, Meta: core.DefaultMeta(), Date: "20240401", PersistentOnly: true}
+		if _, err := snapshot.Do(); err == nil {
+			t.Fatal("Expected error for invalid front matter, got nil")
+		}
+		if _, err := os.Stat(filepath.Join(tmpDir, "20240401")); !os.IsNotExist(err) {
+			t.Error("Expected the partial snapshot directory to be removed")
+		}
+	})
+
+	t.Run("date not after latest", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(tmpDir, "20240201.yaml"), []byte(snapshotContent), 0644); err != nil {
+			t.Fatalf("Failed to create test file: %v", err)
+		}
+
+		for _, date := range []string{"20240101", "20240201"} {
+			snapshot := CreateSnapshot{InputDir: tmpDir, Meta: core.DefaultMeta(), Date: date}
+			if _, err := snapshot.Do(); err == nil {
+				t.Errorf("Expected error for date %s, got nil", date)
+			}
+		}
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		meta := core.DefaultMeta()
+		meta.FileNamePattern = "("
+		snapshot := CreateSnapshot{InputDir: t.TempDir(), Meta: meta, Date: "20240401"}
+		if _, err := snapshot.Do(); err == nil {
+			t.Error("Expected error for invalid fileNamePattern, got nil")
+		}
+	})
+}
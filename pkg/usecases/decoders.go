@@ -0,0 +1,129 @@
+package usecases
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// TechnologiesDecoder parses the content of a technologies file into a YAML node tree,
+// so files of all formats share decoding, key checks and validation.
+// Blank content is returned as an empty document node.
+type TechnologiesDecoder func(data []byte) (*yaml.Node, error)
+
+// technologiesDecoders maps file extensions to the decoders of technologies files.
+var technologiesDecoders = map[string]TechnologiesDecoder{
+	".yaml": decodeYAML,
+	".yml":  decodeYAML,
+	".json": decodeJSON,
+	".toml": decodeTOML,
+}
+
+// RegisterTechnologiesDecoder adds a decoder for technologies files with the given extension
+// (e.g. ".json"), replacing a registered decoder for the same extension.
+func RegisterTechnologiesDecoder(ext string, decoder TechnologiesDecoder) {
+	technologiesDecoders[strings.ToLower(ext)] = decoder
+}
+
+// TechnologiesFileExtensions returns the sorted extensions of supported technologies files.
+func TechnologiesFileExtensions() []string {
+	exts := make([]string, 0, len(technologiesDecoders))
+	for ext := range technologiesDecoders {
+		exts = append(exts, ext)
+	}
+	sort.Strings(exts)
+	return exts
+}
+
+// technologiesDecoder returns the decoder for the extension of the file.
+func technologiesDecoder(filePath string) (TechnologiesDecoder, bool) {
+	decoder, ok := technologiesDecoders[strings.ToLower(filepath.Ext(filePath))]
+	return decoder, ok
+}
+
+// isYAMLFile checks if the file is a YAML file, the only format files are edited in place.
+func isYAMLFile(filePath string) bool {
+	ext := strings.ToLower(filepath.Ext(filePath))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// requireYAMLFile returns an error for technologies files that can't be rewritten.
+func requireYAMLFile(filePath string) error {
+	if !isYAMLFile(filePath) {
+		format := strings.ToUpper(strings.TrimPrefix(filepath.Ext(filePath), "."))
+		return fmt.Errorf("file %s is a %s file, only YAML files can be modified", filePath, format)
+	}
+	return nil
+}
+
+// snapshotDate returns the date part of a technologies file or snapshot directory name,
+// i.e. the name without the extension of a supported format.
+func snapshotDate(fileName string) string {
+	if _, ok := technologiesDecoder(fileName); ok {
+		return strings.TrimSuffix(fileName, filepath.Ext(fileName))
+	}
+	return fileName
+}
+
+// decodeYAML parses YAML content, keeping comments and source positions.
+func decodeYAML(data []byte) (*yaml.Node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("error parsing YAML: %v", err)
+	}
+	return &doc, nil
+}
+
+// decodeJSON parses JSON content. JSON is checked with the JSON parser and then read
+// as YAML (a superset of JSON), which keeps key order and source positions.
+func decodeJSON(data []byte) (*yaml.Node, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return &yaml.Node{}, nil
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			line := bytes.Count(data[:syntaxErr.Offset], []byte("\n")) + 1
+			return nil, fmt.Errorf("error parsing JSON: line %d: %v", line, err)
+		}
+		return nil, fmt.Errorf("error parsing JSON: %v", err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("error parsing JSON: %v", err)
+	}
+	return &doc, nil
+}
+
+// decodeTOML parses TOML content. Technologies are written as an array of tables:
+//
+//	[[technologies]]
+//	name = "Go"
+//
+// Source positions are not available for TOML files.
+func decodeTOML(data []byte) (*yaml.Node, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return &yaml.Node{}, nil
+	}
+
+	var value map[string]interface{}
+	if _, err := toml.Decode(string(data), &value); err != nil {
+		return nil, fmt.Errorf("error parsing TOML: %v", err)
+	}
+
+	var root yaml.Node
+	if err := root.Encode(value); err != nil {
+		return nil, fmt.Errorf("error parsing TOML: %v", err)
+	}
+	return &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{&root}}, nil
+}
@@ -0,0 +1,128 @@
+package usecases
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+func TestReadTechnologiesFilesFormats(t *testing.T) {
+	meta := core.DefaultMeta()
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"20240101.yml": `technologies:
+  - name: "Go"
+    ring: "Trial"
+    quadrant: "Languages"
+    description: "Programming language"
+`,
+		"20240201.json": `{
+	"technologies": [
+		{"name": "Go", "ring": "Adopt", "quadrant": "Languages", "description": "Programming language"},
+		{"name": "Rust", "ring": "Assess", "quadrant": "Languages", "description": "Systems language"}
+	]
+}
+`,
+		"20240301.toml": `[[technologies]]
+name = "Go"
+ring = "Adopt"
+quadrant = "Languages"
+description = "Programming language"
+tags = ["backend"]
+`,
+		"20240401.txt": "not a radar file",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+	}
+
+	result, err := ReadTechnologiesFiles(dir, meta, ReadOptions{})
+	if err != nil {
+		t.Fatalf("ReadTechnologiesFiles failed: %v", err)
+	}
+
+	var dates []string
+	for _, file := range result {
+		dates = append(dates, file.Date)
+	}
+	if !reflect.DeepEqual(dates, []string{"20240101", "20240201", "20240301"}) {
+		t.Fatalf("Unexpected dates: %v", dates)
+	}
+
+	if !result[1].Technologies[0].IsMoved || result[1].Technologies[0].PreviousRing != "Trial" {
+		t.Errorf("Expected Go to be moved from Trial in JSON snapshot, got %+v", result[1].Technologies[0])
+	}
+	if pos := result[1].Technologies[1].Positions.Name; pos.Line != 4 {
+		t.Errorf("Expected JSON position line 4, got %+v", pos)
+	}
+
+	toml := result[2]
+	if len(toml.Technologies) != 2 || !toml.Technologies[1].IsDeleted || toml.Technologies[1].Name != "Rust" {
+		t.Errorf("Expected Go and deleted Rust in TOML snapshot, got %+v", toml.Technologies)
+	}
+	if !reflect.DeepEqual(toml.Technologies[0].Tags, []string{"backend"}) {
+		t.Errorf("Expected tags from TOML, got %v", toml.Technologies[0].Tags)
+	}
+}
+
+func TestCollectValidationErrorsFormats(t *testing.T) {
+	meta := core.DefaultMeta()
+	dir := t.TempDir()
+
+	tests := []struct {
+		name    string
+		content string
+		rule    string
+		message string
+		line    int
+	}{
+		{"20240101.json", "{\n  \"technologies\": [\n    {\"name\": \"Go\",}\n  ]\n}\n", core.RuleSyntax, "error parsing JSON", 3},
+		{"20240102.json", "{\"technologies\": [{\"name\": \"Go\", \"rign\": \"Adopt\", \"quadrant\": \"Languages\", \"description\": \"Go\", \"ring\": \"Adopt\"}]}", core.RuleUnknownField, "did you mean 'ring'?", 1},
+		{"20240103.toml", "[[technologies]]\nname = \"Go\"\nring = \n", core.RuleSyntax, "error parsing TOML", 3},
+		{"20240104.toml", "[[technologies]]\nname = \"Go\"\nring = \"Adopt\"\nquadrant = \"Languages\"\n", core.RuleMissingField, "missing 'description'", 0},
+		{"20240105.json", "  \n", core.RuleEmpty, "no technologies found", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, tt.name)
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("Failed to write file: %v", err)
+			}
+
+			errs := CollectValidationErrors(path, meta, false)
+			if len(errs) != 1 {
+				t.Fatalf("Expected 1 error, got %v", errs)
+			}
+			if errs[0].Rule != tt.rule || !strings.Contains(errs[0].Message, tt.message) || errs[0].Line != tt.line {
+				t.Errorf("Unexpected error %+v", errs[0])
+			}
+		})
+	}
+}
+
+func TestModifyNonYAMLFile(t *testing.T) {
+	meta := core.DefaultMeta()
+	path := filepath.Join(t.TempDir(), "20240101.json")
+	content := `{"technologies": [{"name": "Go", "ring": "Adopt", "quadrant": "Languages", "description": "Go"}]}`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	err := MoveTechnology(path, meta, "Go", "Hold")
+	if err == nil || !strings.Contains(err.Error(), "only YAML files can be modified") {
+		t.Errorf("Expected error for JSON file, got %v", err)
+	}
+
+	changed, err := FormatTechnologiesFiles(filepath.Dir(path), meta, true)
+	if err != nil || len(changed) != 0 {
+		t.Errorf("Expected JSON file to be skipped by fmt, got %v, %v", changed, err)
+	}
+}
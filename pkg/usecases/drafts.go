@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// draftSuffix marks a draft snapshot in a file or directory name, e.g. 20240401.draft.yaml
+// or 20240401.draft/ for a snapshot directory.
+const draftSuffix = ".draft"
+
+// trimDraftSuffix returns the name of a technologies file or snapshot directory without
+// the draft suffix and reports whether the name had it.
+func trimDraftSuffix(name string) (string, bool) {
+	date := snapshotDate(name)
+	if !strings.HasSuffix(date, draftSuffix) {
+		return name, false
+	}
+	return strings.TrimSuffix(date, draftSuffix) + name[len(date):], true
+}
+
+// SnapshotDate returns the date part of the name of a technologies file or snapshot directory:
+// the name without the extension and the draft suffix.
+func SnapshotDate(filePath string) string {
+	name, _ := trimDraftSuffix(filepath.Base(filePath))
+	return snapshotDate(name)
+}
+
+// IsDraft checks if a technologies file or snapshot directory is a draft:
+// its name has the draft suffix or it sets draft: true.
+func IsDraft(filePath string) (bool, error) {
+	if _, ok := trimDraftSuffix(filepath.Base(filePath)); ok {
+		return true, nil
+	}
+	technologiesFile, _, err := parseTechnologiesFile(filePath)
+	if err != nil {
+		return false, err
+	}
+	return technologiesFile.Draft, nil
+}
@@ -0,0 +1,109 @@
+package usecases
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+func TestSnapshotDate(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{path: "data/20240101.yaml", expected: "20240101"},
+		{path: "data/20240101.draft.yaml", expected: "20240101"},
+		{path: "data/20240101.draft.json", expected: "20240101"},
+		{path: "data/20240101.draft", expected: "20240101"},
+		{path: "data/20240101", expected: "20240101"},
+	}
+
+	for _, tt := range tests {
+		if got := SnapshotDate(tt.path); got != tt.expected {
+			t.Errorf("SnapshotDate(%q) = %q, expected %q", tt.path, got, tt.expected)
+		}
+	}
+}
+
+func TestReadTechnologiesFilesDrafts(t *testing.T) {
+	inputDir := t.TempDir()
+	files := map[string]string{
+		"20240101.yaml": `technologies:
+  - name: "Go"
+    ring: "Trial"
+    quadrant: "Languages"
+    description: "Programming language"
+`,
+		"20240201.yaml": `draft: true
+technologies:
+  - name: "Go"
+    ring: "Adopt"
+    quadrant: "Languages"
+    description: "Programming language"
+`,
+		"20240301.draft.yaml": `technologies:
+  - name: "Go"
+    ring: "Hold"
+    quadrant: "Languages"
+    description: "Programming language"
+`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(inputDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+	}
+	writeSnapshotDir(t, filepath.Join(inputDir, "20240401"), map[string]string{
+		"index.md":        "---\ndraft: true\n---\n",
+		"Languages/Go.md": "---\nring: Assess\n---\nProgramming language\n",
+	})
+
+	radarFiles, err := GetRadarFiles(inputDir, core.DefaultMeta())
+	if err != nil {
+		t.Fatalf("GetRadarFiles failed: %v", err)
+	}
+	if len(radarFiles) != 4 {
+		t.Fatalf("Expected drafts in radar files, got %v", radarFiles)
+	}
+	for i, expected := range []bool{false, true, true, true} {
+		draft, err := IsDraft(radarFiles[i])
+		if err != nil || draft != expected {
+			t.Errorf("IsDraft(%s) = %v, %v, expected %v", radarFiles[i], draft, err, expected)
+		}
+	}
+
+	published, err := ReadTechnologiesFiles(inputDir, core.DefaultMeta(), ReadOptions{})
+	if err != nil {
+		t.Fatalf("ReadTechnologiesFiles failed: %v", err)
+	}
+	if len(published) != 1 || published[0].Date != "20240101" {
+		t.Errorf("Expected only the published snapshot, got %+v", published)
+	}
+
+	meta := core.DefaultMeta()
+	all, err := ReadTechnologiesFiles(inputDir, meta, ReadOptions{IncludeDrafts: true})
+	if err != nil {
+		t.Fatalf("ReadTechnologiesFiles failed: %v", err)
+	}
+	if len(all) != 4 {
+		t.Fatalf("Expected all snapshots with drafts, got %+v", all)
+	}
+	if all[2].Date != "20240301" || !all[2].Draft || all[2].Technologies[0].PreviousRing != "Adopt" {
+		t.Errorf("Expected draft compared to the previous draft, got %+v", all[2])
+	}
+
+	// Drafts are validated
+	if err := os.WriteFile(filepath.Join(inputDir, "20240301.draft.yaml"), []byte("technologies:\n  - name: Go\n    ring: Never\n"), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	report, err := ValidateRadar(inputDir, core.DefaultMeta(), time.Now(), false)
+	if err != nil {
+		t.Fatalf("ValidateRadar failed: %v", err)
+	}
+	if report.ErrorCount() == 0 {
+		t.Error("Expected errors in the draft snapshot")
+	}
+}
@@ -0,0 +1,182 @@
+package usecases
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+// PossibleTypo represents a technology that appeared in a snapshot at the same time
+// a similarly named technology disappeared from it, e.g. "Kubernets" replacing "Kubernetes".
+type PossibleTypo struct {
+	Name string `json:"name"`
+	// Similar is the name of the technology deleted in the same snapshot
+	Similar string `json:"similar"`
+	// Index of the snapshot in the list of files
+	Index    int           `json:"-"`
+	Date     string        `json:"date"`
+	Position core.Position `json:"-"`
+}
+
+// String returns a human-readable description of the possible typo.
+func (p PossibleTypo) String() string {
+	return fmt.Sprintf("technology '%s' may be a typo of '%s' (new '%s' and deleted '%s' in the same snapshot)",
+		p.Name, p.Similar, p.Name, p.Similar)
+}
+
+// duplicateErrors returns errors for technologies listed more than once in a snapshot,
+// either with exactly the same name or with a name differing only in case or whitespace.
+func duplicateErrors(filePath string, technologies []core.Technology) core.ValidationErrors {
+	var errs core.ValidationErrors
+	seen := make(map[string]core.Technology)
+
+	for _, tech := range technologies {
+		if tech.Name == "" {
+			continue
+		}
+		key := normalizeName(tech.Name)
+		first, ok := seen[key]
+		if !ok {
+			seen[key] = tech
+			continue
+		}
+
+		definedAt := fmt.Sprintf("line %d", first.Positions.Entry.Line)
+		if first.Positions.Entry.File != "" {
+			definedAt = first.Positions.Entry.File
+		}
+		message := fmt.Sprintf("duplicate technology '%s' (first defined at %s)", tech.Name, definedAt)
+		if first.Name != tech.Name {
+			message = fmt.Sprintf("technology '%s' duplicates '%s' (first defined at %s)", tech.Name, first.Name, definedAt)
+		}
+		pos := tech.FieldPosition("name")
+		errs = append(errs, core.ValidationError{
+			File:       cmp.Or(pos.File, filePath),
+			Technology: tech.Name,
+			Field:      "name",
+			Rule:       core.RuleDuplicate,
+			Severity:   core.SeverityError,
+			Message:    message,
+			Line:       pos.Line,
+			Column:     pos.Column,
+		})
+	}
+
+	return errs
+}
+
+// FindPossibleTypos returns technologies that first appeared in a snapshot while a technology
+// with a similar name was deleted in the same snapshot. Files must be sorted by date
+// (as returned by ReadTechnologiesFiles).
+func FindPossibleTypos(files []core.TechnologiesFile) []PossibleTypo {
+	var typos []PossibleTypo
+	known := make(map[string]bool)
+
+	for i, file := range files {
+		var added, deleted []core.Technology
+		for _, tech := range file.Technologies {
+			switch {
+			case tech.IsDeleted:
+				deleted = append(deleted, tech)
+			case i > 0 && tech.IsNew && !known[tech.Name]:
+				added = append(added, tech)
+			}
+		}
+
+		for _, tech := range added {
+			for _, old := range deleted {
+				if isSimilarName(tech.Name, old.Name) {
+					typos = append(typos, PossibleTypo{
+						Name:     tech.Name,
+						Similar:  old.Name,
+						Index:    i,
+						Date:     file.Date,
+						Position: tech.FieldPosition("name"),
+					})
+					break
+				}
+			}
+		}
+
+		for _, tech := range file.Technologies {
+			if !tech.IsDeleted {
+				known[tech.Name] = true
+			}
+		}
+	}
+
+	return typos
+}
+
+// normalizeName returns the name in lower case without whitespace,
+// so names differing only in case or whitespace are equal.
+func normalizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, name)
+}
+
+// isSimilarName returns true if two different names likely refer to the same technology:
+// they differ only in case or whitespace, or by a small edit distance
+// (1 edit for names up to 7 characters, 2 for longer ones, none for names up to 3 characters).
+// Names that differ only in digits are versions, e.g. "Vue 2" and "Vue 3", and are not similar.
+func isSimilarName(a, b string) bool {
+	if a == b {
+		return false
+	}
+	na, nb := normalizeName(a), normalizeName(b)
+	if na != nb && removeDigits(na) == removeDigits(nb) {
+		return false
+	}
+	length := min(len([]rune(na)), len([]rune(nb)))
+
+	maxDistance := 2
+	switch {
+	case length <= 3:
+		maxDistance = 0
+	case length <= 7:
+		maxDistance = 1
+	}
+
+	return levenshtein(na, nb) <= maxDistance
+}
+
+// removeDigits returns the string without decimal digits.
+func removeDigits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// levenshtein returns the edit distance between two strings.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
@@ -0,0 +1,121 @@
+package usecases
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+func TestCollectValidationErrorsDuplicates(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "20240101.yaml")
+	content := `technologies:
+  - name: "Go"
+    ring: "Adopt"
+    quadrant: "Languages"
+    description: "Programming language"
+  - name: "Kubernetes"
+    ring: "Adopt"
+    quadrant: "Platforms"
+    description: "Container orchestration"
+  - name: "Go"
+    ring: "Trial"
+    quadrant: "Languages"
+    description: "Again"
+  - name: " kubernetes"
+    ring: "Trial"
+    quadrant: "Platforms"
+    description: "Lower case"
+`
+	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	errs := CollectValidationErrors(filePath, core.DefaultMeta(), false)
+	if len(errs) != 2 {
+		t.Fatalf("Expected 2 errors, got %d: %v", len(errs), errs)
+	}
+	if errs[0].Rule != core.RuleDuplicate || errs[0].Line != 10 ||
+		errs[0].Message != "duplicate technology 'Go' (first defined at line 2)" {
+		t.Errorf("Unexpected exact duplicate error: %+v", errs[0])
+	}
+	if errs[1].Rule != core.RuleDuplicate || errs[1].Line != 14 ||
+		errs[1].Message != "technology ' kubernetes' duplicates 'Kubernetes' (first defined at line 6)" {
+		t.Errorf("Unexpected near duplicate error: %+v", errs[1])
+	}
+}
+
+func TestFindPossibleTypos(t *testing.T) {
+	tech := func(name string) core.Technology {
+		return core.Technology{Name: name, Ring: "Adopt", Quadrant: "Platforms", Description: name}
+	}
+	files := []core.TechnologiesFile{
+		{Date: "20240101", Technologies: []core.Technology{tech("Kubernetes"), tech("React"), tech("Go")}},
+		{Date: "20240201", Technologies: []core.Technology{tech("Kubernets"), tech("Preact"), tech("React"), tech("Go")}},
+		{Date: "20240301", Technologies: []core.Technology{tech("Kubernetes"), tech("Preact"), tech("React"), tech("Io")}},
+	}
+	for i := range files {
+		if i == 0 {
+			continue
+		}
+		markChanges(&files[i].Technologies, files[i-1].Technologies)
+	}
+
+	typos := FindPossibleTypos(files)
+	if len(typos) != 1 {
+		t.Fatalf("Expected 1 possible typo, got %+v", typos)
+	}
+	if typos[0].Name != "Kubernets" || typos[0].Similar != "Kubernetes" || typos[0].Index != 1 || typos[0].Date != "20240201" {
+		t.Errorf("Unexpected typo: %+v", typos[0])
+	}
+	if !strings.Contains(typos[0].String(), "'Kubernets' may be a typo of 'Kubernetes'") {
+		t.Errorf("Unexpected message: %s", typos[0].String())
+	}
+}
+
+func TestIsSimilarName(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"Kubernetes", "Kubernets", true},
+		{"Kubernetes", "kubernetes", true},
+		{"GitHub Actions", "Github  Actions", true},
+		{"Terraform", "Terrafrom", true},
+		{"Kafka", "Kafak", false},
+		{"React", "Preact", true},
+		{"Go", "Io", false},
+		{"Go", "go", true},
+		{"Docker", "Podman", false},
+		{"Go", "Go", false},
+		{"Vue 2", "Vue 3", false},
+		{"Python 2", "Python 3", false},
+		{"Angular", "Angular 2", false},
+		{"Vue 2", "vue2", true},
+	}
+	for _, tt := range tests {
+		if got := isSimilarName(tt.a, tt.b); got != tt.want {
+			t.Errorf("isSimilarName(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"kubernetes", "kubernets", 1},
+		{"привет", "привт", 1},
+	}
+	for _, tt := range tests {
+		if got := levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package usecases
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+// TechnologyUpdate holds the fields to change in an existing technology.
+// Empty fields are left unchanged.
+type TechnologyUpdate struct {
+	Name        string
+	Ring        string
+	Quadrant    string
+	Description string
+}
+
+// AddTechnology appends a new technology to the technologies file.
+// Comments and key order of the file are kept.
+func AddTechnology(filePath string, meta core.Meta, tech core.Technology) error {
+	if tech.Name == "" || tech.Ring == "" || tech.Quadrant == "" || tech.Description == "" {
+		return fmt.Errorf("name, ring, quadrant and description are required")
+	}
+	if err := validateRingAndQuadrant(meta, tech.Ring, tech.Quadrant); err != nil {
+		return err
+	}
+
+	data, doc, technologies, err := readTechnologiesNode(filePath)
+	if err != nil {
+		return err
+	}
+
+	if findTechnologyNode(technologies, tech.Name) >= 0 {
+		return fmt.Errorf("technology '%s' already exists", tech.Name)
+	}
+
+	item := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	setNodeField(item, "name", tech.Name)
+	setNodeField(item, "ring", tech.Ring)
+	setNodeField(item, "quadrant", tech.Quadrant)
+	setNodeField(item, "description", tech.Description)
+
+	if !isBlockStyle(technologies) || len(technologies.Content) == 0 {
+		technologies.Content = append(technologies.Content, item)
+		return writeTechnologiesNode(filePath, doc)
+	}
+
+	// Insert the entry after the last one, with the same indentation
+	text, err := marshalYAML(&yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq", Content: []*yaml.Node{item}})
+	if err != nil {
+		return err
+	}
+	lines := splitLines(data)
+	last := technologies.Content[len(technologies.Content)-1]
+	indent := strings.Repeat(" ", lineIndent(lines[last.Line-1]))
+	_, end := entryLines(lines, last.Line, len(indent))
+	return os.WriteFile(filePath, replaceLines(lines, end, end, string(text), indent, indent), 0644)
+}
+
+// MoveTechnology changes the ring of a technology in the technologies file.
+func MoveTechnology(filePath string, meta core.Meta, name, ring string) error {
+	return EditTechnology(filePath, meta, name, TechnologyUpdate{Ring: ring})
+}
+
+// EditTechnology updates fields of a technology in the technologies file.
+// Comments and key order of the file are kept.
+func EditTechnology(filePath string, meta core.Meta, name string, update TechnologyUpdate) error {
+	if err := validateRingAndQuadrant(meta, update.Ring, update.Quadrant); err != nil {
+		return err
+	}
+
+	data, doc, technologies, err := readTechnologiesNode(filePath)
+	if err != nil {
+		return err
+	}
+
+	index := findTechnologyNode(technologies, name)
+	if index < 0 {
+		return fmt.Errorf("technology '%s' not found", name)
+	}
+
+	if update.Name != "" {
+		if other := findTechnologyNode(technologies, update.Name); other >= 0 && other != index {
+			return fmt.Errorf("technology '%s' already exists", update.Name)
+		}
+	}
+
+	fields := []struct{ key, value string }{
+		{"name", update.Name},
+		{"ring", update.Ring},
+		{"quadrant", update.Quadrant},
+		{"description", update.Description},
+	}
+
+	item := technologies.Content[index]
+	if !isBlockStyle(technologies) || !isBlockStyle(item) {
+		for _, f := range fields {
+			if f.value != "" {
+				setNodeField(item, f.key, f.value)
+			}
+		}
+		return writeTechnologiesNode(filePath, doc)
+	}
+
+	// Fields are changed one at a time, the content is parsed again for the new positions
+	for _, f := range fields {
+		if f.value == "" {
+			continue
+		}
+		if data, err = setEntryField(data, item, f.key, f.value); err != nil {
+			return err
+		}
+		var doc yaml.Node
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return fmt.Errorf("error parsing YAML: %v", err)
+		}
+		item = mappingValue(documentRoot(&doc), "technologies").Content[index]
+	}
+	return os.WriteFile(filePath, data, 0644)
+}
+
+// RemoveTechnology removes a technology from the technologies file.
+func RemoveTechnology(filePath string, name string) error {
+	data, doc, technologies, err := readTechnologiesNode(filePath)
+	if err != nil {
+		return err
+	}
+
+	index := findTechnologyNode(technologies, name)
+	if index < 0 {
+		return fmt.Errorf("technology '%s' not found", name)
+	}
+
+	if !isBlockStyle(technologies) {
+		technologies.Content = append(technologies.Content[:index], technologies.Content[index+1:]...)
+		return writeTechnologiesNode(filePath, doc)
+	}
+	return os.WriteFile(filePath, removeEntries(data, technologies.Content[index:index+1]), 0644)
+}
+
+// validateRingAndQuadrant checks non-empty ring and quadrant against the meta.
+func validateRingAndQuadrant(meta core.Meta, ring, quadrant string) error {
+	if ring != "" && !meta.IsValidRing(ring) {
+		return fmt.Errorf("invalid ring '%s'", ring)
+	}
+	if quadrant != "" && !meta.IsValidQuadrant(quadrant) {
+		return fmt.Errorf("invalid quadrant '%s'", quadrant)
+	}
+	return nil
+}
+
+// readTechnologiesNode parses a technologies file into a YAML node tree.
+// Returns the content, the document node and the technologies sequence node.
+// Only YAML files are supported, as they are written back.
+func readTechnologiesNode(filePath string) ([]byte, *yaml.Node, *yaml.Node, error) {
+	if info, err := os.Stat(filePath); err == nil && info.IsDir() {
+		return nil, nil, nil, fmt.Errorf("snapshot %s uses the directory layout, edit its technology files directly", filePath)
+	}
+	if err := requireYAMLFile(filePath); err != nil {
+		return nil, nil, nil, err
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error reading file: %v", err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, nil, nil, fmt.Errorf("error parsing YAML: %v", err)
+	}
+
+	technologies := mappingValue(documentRoot(&doc), "technologies")
+	if technologies == nil || technologies.Kind != yaml.SequenceNode {
+		return nil, nil, nil, fmt.Errorf("no technologies list found in file %s", filePath)
+	}
+
+	return data, &doc, technologies, nil
+}
+
+// writeTechnologiesNode writes a YAML node tree back to the technologies file.
+// Used for flow style lists, whose entries can't be edited line by line.
+func writeTechnologiesNode(filePath string, doc *yaml.Node) error {
+	data, err := marshalYAML(doc)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filePath, data, 0644)
+}
+
+// findTechnologyNode returns the index of the technology with the given name, or -1.
+// Names are compared case-insensitively, as in the technology history.
+func findTechnologyNode(technologies *yaml.Node, name string) int {
+	for i, item := range technologies.Content {
+		if n := mappingValue(item, "name"); n != nil && strings.EqualFold(strings.TrimSpace(n.Value), strings.TrimSpace(name)) {
+			return i
+		}
+	}
+	return -1
+}
+
+// setNodeField sets a string value in a mapping node, keeping the position of an existing key.
+// New values are double-quoted, the style used in the radar files.
+func setNodeField(node *yaml.Node, key, value string) {
+	if v := mappingValue(node, key); v != nil {
+		v.Value = value
+		v.Tag = "!!str"
+		return
+	}
+	node.Content = append(node.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value, Style: yaml.DoubleQuotedStyle},
+	)
+}
+
+// setEntryField sets a field of a block style technology entry in the content, changing only
+// the lines of the field and keeping the quoting style and the comment of an existing value.
+// A missing field is added after the last field of the entry.
+func setEntryField(data []byte, item *yaml.Node, key, value string) ([]byte, error) {
+	lines := splitLines(data)
+	field := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	setNodeField(field, key, value)
+
+	for i := 0; i+1 < len(item.Content); i += 2 {
+		k, v := item.Content[i], item.Content[i+1]
+		if k.Value != key {
+			continue
+		}
+		newValue := field.Content[1]
+		if v.Kind == yaml.ScalarNode && v.Style&(yaml.LiteralStyle|yaml.FoldedStyle) == 0 {
+			newValue.Style = v.Style
+		}
+		newValue.LineComment = v.LineComment
+		text, err := marshalYAML(field)
+		if err != nil {
+			return nil, err
+		}
+		start, end := entryLines(lines, k.Line, k.Column-1)
+		prefix := lines[start][:k.Column-1]
+		return replaceLines(lines, start, end, string(text), prefix, strings.Repeat(" ", k.Column-1)), nil
+	}
+
+	text, err := marshalYAML(field)
+	if err != nil {
+		return nil, err
+	}
+	_, end := entryLines(lines, item.Line, lineIndent(lines[item.Line-1]))
+	indent := strings.Repeat(" ", item.Content[0].Column-1)
+	return replaceLines(lines, end, end, string(text), indent, indent), nil
+}
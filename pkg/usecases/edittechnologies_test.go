@@ -0,0 +1,224 @@
+package usecases
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+const editContent = `# Radar snapshot
+technologies:
+  # Main language
+  - name: "Go"
+    ring: "Trial" # since 2023
+    quadrant: "Languages"
+    description: "Programming language"
+  - name: "Colima"
+    ring: "Assess"
+    quadrant: "Platforms"
+    description: "Docker Desktop alternative"
+`
+
+// writeEditFile creates a technologies file for edit tests
+func writeEditFile(t *testing.T) string {
+	filePath := filepath.Join(t.TempDir(), "20240101.yaml")
+	if err := os.WriteFile(filePath, []byte(editContent), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+	return filePath
+}
+
+// readTechnologies reads technologies from a file for edit tests
+func readTechnologies(t *testing.T, filePath string) (string, []core.Technology) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("Failed to read file: %v", err)
+	}
+	tf, err := readTechnologiesFile(filePath, core.DefaultMeta(), false)
+	if err != nil {
+		t.Fatalf("Failed to parse file: %v", err)
+	}
+	// Source positions change with every edit, compare content only
+	for i := range tf.Technologies {
+		tf.Technologies[i].Positions = core.TechnologyPositions{}
+	}
+	return string(data), tf.Technologies
+}
+
+func TestAddTechnology(t *testing.T) {
+	meta := core.DefaultMeta()
+	filePath := writeEditFile(t)
+
+	tech := core.Technology{Name: "Kafka", Ring: "Trial", Quadrant: "Platforms", Description: "Event streaming"}
+	if err := AddTechnology(filePath, meta, tech); err != nil {
+		t.Fatalf("AddTechnology failed: %v", err)
+	}
+
+	content, technologies := readTechnologies(t, filePath)
+	if len(technologies) != 3 || !reflect.DeepEqual(technologies[2], tech) {
+		t.Errorf("Expected Kafka to be appended, got %+v", technologies)
+	}
+	if !strings.Contains(content, "# Main language") || !strings.Contains(content, "# since 2023") {
+		t.Errorf("Expected comments to be kept, got:\n%s", content)
+	}
+
+	// Duplicate
+	if err := AddTechnology(filePath, meta, tech); err == nil {
+		t.Error("Expected error for existing technology, got nil")
+	}
+
+	// Invalid ring
+	tech = core.Technology{Name: "Zig", Ring: "Later", Quadrant: "Languages", Description: "Language"}
+	if err := AddTechnology(filePath, meta, tech); err == nil {
+		t.Error("Expected error for invalid ring, got nil")
+	}
+
+	// Missing description
+	tech = core.Technology{Name: "Zig", Ring: "Hold", Quadrant: "Languages"}
+	if err := AddTechnology(filePath, meta, tech); err == nil {
+		t.Error("Expected error for missing description, got nil")
+	}
+}
+
+func TestMoveTechnology(t *testing.T) {
+	meta := core.DefaultMeta()
+	filePath := writeEditFile(t)
+
+	if err := MoveTechnology(filePath, meta, "Go", "Adopt"); err != nil {
+		t.Fatalf("MoveTechnology failed: %v", err)
+	}
+
+	content, technologies := readTechnologies(t, filePath)
+	if technologies[0].Ring != "Adopt" {
+		t.Errorf("Expected Go to be in Adopt, got %s", technologies[0].Ring)
+	}
+	if !strings.Contains(content, "# since 2023") {
+		t.Errorf("Expected inline comment to be kept, got:\n%s", content)
+	}
+
+	if err := MoveTechnology(filePath, meta, "Go", "Later"); err == nil {
+		t.Error("Expected error for invalid ring, got nil")
+	}
+	if err := MoveTechnology(filePath, meta, "Rust", "Adopt"); err == nil {
+		t.Error("Expected error for unknown technology, got nil")
+	}
+}
+
+func TestEditTechnology(t *testing.T) {
+	meta := core.DefaultMeta()
+	filePath := writeEditFile(t)
+
+	update := TechnologyUpdate{Name: "Colima VM", Quadrant: "Techniques", Description: "Container runtime"}
+	if err := EditTechnology(filePath, meta, "Colima", update); err != nil {
+		t.Fatalf("EditTechnology failed: %v", err)
+	}
+
+	_, technologies := readTechnologies(t, filePath)
+	expected := core.Technology{Name: "Colima VM", Ring: "Assess", Quadrant: "Techniques", Description: "Container runtime"}
+	if !reflect.DeepEqual(technologies[1], expected) {
+		t.Errorf("Expected %+v, got %+v", expected, technologies[1])
+	}
+
+	// Renaming to an existing name
+	if err := EditTechnology(filePath, meta, "Colima VM", TechnologyUpdate{Name: "Go"}); err == nil {
+		t.Error("Expected error when renaming to an existing technology, got nil")
+	}
+
+	// Invalid quadrant
+	if err := EditTechnology(filePath, meta, "Go", TechnologyUpdate{Quadrant: "Tools"}); err == nil {
+		t.Error("Expected error for invalid quadrant, got nil")
+	}
+}
+
+func TestRemoveTechnology(t *testing.T) {
+	filePath := writeEditFile(t)
+
+	if err := RemoveTechnology(filePath, "Go"); err != nil {
+		t.Fatalf("RemoveTechnology failed: %v", err)
+	}
+
+	content, technologies := readTechnologies(t, filePath)
+	if len(technologies) != 1 || technologies[0].Name != "Colima" {
+		t.Errorf("Expected only Colima to remain, got %+v", technologies)
+	}
+	if !strings.Contains(content, "# Radar snapshot") {
+		t.Errorf("Expected header comment to be kept, got:\n%s", content)
+	}
+
+	if err := RemoveTechnology(filePath, "Go"); err == nil {
+		t.Error("Expected error for unknown technology, got nil")
+	}
+}
+
+func TestEditTechnologiesKeepFormatting(t *testing.T) {
+	meta := core.DefaultMeta()
+	filePath := filepath.Join(t.TempDir(), "20240101.yaml")
+	content := `technologies:
+# Main language
+- name: Go
+  ring: 'Trial' # since 2023
+  quadrant: Languages
+  description: |
+    Programming language
+
+    with goroutines
+
+# Containers
+- name: Colima
+  ring: Assess
+  quadrant: Platforms
+  description: Docker Desktop alternative
+`
+	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	if err := EditTechnology(filePath, meta, "go", TechnologyUpdate{Ring: "Adopt", Description: "Language"}); err != nil {
+		t.Fatalf("EditTechnology failed: %v", err)
+	}
+	if err := RemoveTechnology(filePath, "COLIMA"); err != nil {
+		t.Fatalf("RemoveTechnology failed: %v", err)
+	}
+	tech := core.Technology{Name: "Kafka", Ring: "Trial", Quadrant: "Platforms", Description: "Event streaming"}
+	if err := AddTechnology(filePath, meta, tech); err != nil {
+		t.Fatalf("AddTechnology failed: %v", err)
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("Failed to read file: %v", err)
+	}
+	expected := `technologies:
+# Main language
+- name: Go
+  ring: 'Adopt' # since 2023
+  quadrant: Languages
+  description: "Language"
+- name: "Kafka"
+  ring: "Trial"
+  quadrant: "Platforms"
+  description: "Event streaming"
+`
+	if string(data) != expected {
+		t.Errorf("Expected only the changed lines to differ, got:\n%s", data)
+	}
+
+	// Renaming changes the case of the name only
+	if err := EditTechnology(filePath, meta, "Go", TechnologyUpdate{Name: "GO"}); err != nil {
+		t.Errorf("Expected renaming to another case to succeed, got %v", err)
+	}
+}
+
+func TestEditTechnologiesDirectoryLayout(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "20240101")
+	writeSnapshotDir(t, dir, map[string]string{"languages/go.md": "---\nring: adopt\n---\n"})
+
+	err := RemoveTechnology(dir, "go")
+	if err == nil || !strings.Contains(err.Error(), "directory layout") {
+		t.Errorf("Expected error for the directory layout, got %v", err)
+	}
+}
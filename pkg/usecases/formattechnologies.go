@@ -0,0 +1,180 @@
+package usecases
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+// FormatTechnologies returns the technologies file content in canonical form:
+// entries sorted by quadrant and ring (in meta order) and then by name,
+// keys in the order of core.Technology fields and string values double-quoted.
+// Comments are kept with their entries.
+func FormatTechnologies(data []byte, meta core.Meta) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("error parsing YAML: %v", err)
+	}
+
+	technologies := mappingValue(documentRoot(&doc), "technologies")
+	if technologies == nil || technologies.Kind != yaml.SequenceNode {
+		return nil, fmt.Errorf("no technologies list found")
+	}
+
+	keyOrder := yamlFieldNames(reflect.TypeOf(core.Technology{}))
+	for _, item := range technologies.Content {
+		if item.Kind != yaml.MappingNode {
+			return nil, fmt.Errorf("line %d: technology must be a mapping", item.Line)
+		}
+		sortMappingKeys(item, keyOrder)
+		normalizeScalars(item)
+	}
+
+	sort.SliceStable(technologies.Content, func(i, j int) bool {
+		a, b := technologies.Content[i], technologies.Content[j]
+		if qa, qb := formatQuadrantIndex(a, meta), formatQuadrantIndex(b, meta); qa != qb {
+			return qa < qb
+		}
+		if ra, rb := formatRingIndex(a, meta), formatRingIndex(b, meta); ra != rb {
+			return ra < rb
+		}
+		return strings.ToLower(nodeString(a, "name")) < strings.ToLower(nodeString(b, "name"))
+	})
+	technologies.Style = 0
+
+	return marshalYAML(&doc)
+}
+
+// FormatTechnologiesFiles formats all YAML technologies files in the input directory,
+// files of other formats are skipped.
+// It returns the files that are not in canonical form; they are rewritten unless check is set.
+func FormatTechnologiesFiles(inputDir string, meta core.Meta, check bool) ([]string, error) {
+	var changed []string
+
+	files, err := GetRadarFiles(inputDir, meta)
+	if err != nil {
+		return changed, err
+	}
+
+	for _, file := range files {
+		if !isYAMLFile(file) {
+			continue
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return changed, fmt.Errorf("error reading file: %v", err)
+		}
+
+		formatted, err := FormatTechnologies(data, meta)
+		if err != nil {
+			return changed, fmt.Errorf("%s: %v", file, err)
+		}
+		if bytes.Equal(data, formatted) {
+			continue
+		}
+
+		changed = append(changed, file)
+		if !check {
+			if err := os.WriteFile(file, formatted, 0644); err != nil {
+				return changed, err
+			}
+		}
+	}
+
+	return changed, nil
+}
+
+// sortMappingKeys orders the keys of a mapping node: known keys in the given order,
+// then unknown keys in their original order.
+func sortMappingKeys(node *yaml.Node, order []string) {
+	rank := func(key string) int {
+		for i, k := range order {
+			if k == key {
+				return i
+			}
+		}
+		return len(order)
+	}
+
+	type pair struct{ key, value *yaml.Node }
+	pairs := make([]pair, 0, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		pairs = append(pairs, pair{node.Content[i], node.Content[i+1]})
+	}
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return rank(pairs[i].key.Value) < rank(pairs[j].key.Value)
+	})
+
+	node.Content = node.Content[:0]
+	for _, p := range pairs {
+		node.Content = append(node.Content, p.key, p.value)
+	}
+	node.Style = 0
+}
+
+// normalizeScalars makes keys plain and string values double-quoted.
+// Multi-line strings keep literal or folded style, sequences of strings use flow style.
+func normalizeScalars(node *yaml.Node) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		key.Style = 0
+
+		switch value.Kind {
+		case yaml.ScalarNode:
+			normalizeStringScalar(value)
+		case yaml.SequenceNode:
+			value.Style = yaml.FlowStyle
+			for _, item := range value.Content {
+				normalizeStringScalar(item)
+			}
+		}
+	}
+}
+
+// normalizeStringScalar double-quotes a string scalar unless it is a multi-line block.
+func normalizeStringScalar(node *yaml.Node) {
+	if node.Kind != yaml.ScalarNode || node.ShortTag() != "!!str" {
+		return
+	}
+	if node.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0 && strings.Contains(node.Value, "\n") {
+		return
+	}
+	node.Style = yaml.DoubleQuotedStyle
+}
+
+// nodeString returns the string value of a key in a mapping node.
+func nodeString(node *yaml.Node, key string) string {
+	if v := mappingValue(node, key); v != nil {
+		return v.Value
+	}
+	return ""
+}
+
+// formatQuadrantIndex returns the meta position of the entry's quadrant, unknown quadrants go last.
+func formatQuadrantIndex(node *yaml.Node, meta core.Meta) int {
+	quadrant := nodeString(node, "quadrant")
+	for i, q := range meta.Quadrants {
+		if strings.EqualFold(q.Name, quadrant) || strings.EqualFold(q.Alias, quadrant) {
+			return i
+		}
+	}
+	return len(meta.Quadrants)
+}
+
+// formatRingIndex returns the meta position of the entry's ring, unknown rings go last.
+func formatRingIndex(node *yaml.Node, meta core.Meta) int {
+	ring := nodeString(node, "ring")
+	for i, r := range meta.Rings {
+		if strings.EqualFold(r.Name, ring) || strings.EqualFold(r.Alias, ring) {
+			return i
+		}
+	}
+	return len(meta.Rings)
+}
@@ -0,0 +1,120 @@
+package usecases
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+const unformattedContent = `# Snapshot
+technologies:
+  # Frontend
+  - description: Library for creating user interfaces
+    name: React
+    quadrant: 'Frameworks'
+    ring: "Trial" # since 2023
+    tags: [web, 'ui']
+  - name: "Go"
+    ring: "Trial"
+    quadrant: "Languages"
+    description: |
+      Effective programming language
+      with goroutines
+  - {name: Docker, ring: Adopt, quadrant: Platforms, description: Containers}
+  - name: "Angular"
+    ring: "Hold"
+    quadrant: "Frameworks"
+    description: "Old"
+    replacedBy: React
+  - name: "Assembly"
+    ring: "Hold"
+    quadrant: "Languages"
+    description: "Low level"
+`
+
+const formattedContent = `# Snapshot
+technologies:
+  - name: "Go"
+    ring: "Trial"
+    quadrant: "Languages"
+    description: |
+      Effective programming language
+      with goroutines
+  - name: "Assembly"
+    ring: "Hold"
+    quadrant: "Languages"
+    description: "Low level"
+  # Frontend
+  - name: "React"
+    ring: "Trial" # since 2023
+    quadrant: "Frameworks"
+    description: "Library for creating user interfaces"
+    tags: ["web", "ui"]
+  - name: "Angular"
+    ring: "Hold"
+    quadrant: "Frameworks"
+    description: "Old"
+    replacedBy: "React"
+  - name: "Docker"
+    ring: "Adopt"
+    quadrant: "Platforms"
+    description: "Containers"
+`
+
+func TestFormatTechnologies(t *testing.T) {
+	meta := core.DefaultMeta()
+
+	formatted, err := FormatTechnologies([]byte(unformattedContent), meta)
+	if err != nil {
+		t.Fatalf("FormatTechnologies failed: %v", err)
+	}
+	if string(formatted) != formattedContent {
+		t.Errorf("Unexpected formatting:\n%s\nexpected:\n%s", formatted, formattedContent)
+	}
+
+	// Formatting is idempotent
+	again, err := FormatTechnologies(formatted, meta)
+	if err != nil {
+		t.Fatalf("FormatTechnologies failed: %v", err)
+	}
+	if string(again) != string(formatted) {
+		t.Errorf("Expected formatting to be idempotent, got:\n%s", again)
+	}
+
+	if _, err := FormatTechnologies([]byte("title: \"No technologies\"\n"), meta); err == nil {
+		t.Error("Expected error for file without technologies, got nil")
+	}
+}
+
+func TestFormatTechnologiesFiles(t *testing.T) {
+	meta := core.DefaultMeta()
+	dir := t.TempDir()
+	unformatted := filepath.Join(dir, "20240101.yaml")
+	formatted := filepath.Join(dir, "20240201.yaml")
+	if err := os.WriteFile(unformatted, []byte(unformattedContent), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	if err := os.WriteFile(formatted, []byte(formattedContent), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	changed, err := FormatTechnologiesFiles(dir, meta, true)
+	if err != nil {
+		t.Fatalf("FormatTechnologiesFiles failed: %v", err)
+	}
+	if len(changed) != 1 || changed[0] != unformatted {
+		t.Errorf("Expected only %s to need formatting, got %v", unformatted, changed)
+	}
+	if data, _ := os.ReadFile(unformatted); string(data) != unformattedContent {
+		t.Error("Expected file not to be rewritten in check mode")
+	}
+
+	if _, err := FormatTechnologiesFiles(dir, meta, false); err != nil {
+		t.Fatalf("FormatTechnologiesFiles failed: %v", err)
+	}
+	if data, _ := os.ReadFile(unformatted); string(data) != formattedContent {
+		t.Errorf("Expected file to be formatted, got:\n%s", data)
+	}
+}
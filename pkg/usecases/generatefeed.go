@@ -0,0 +1,123 @@
+package usecases
+
+import (
+	"cmp"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+// feedFile is the name of the Atom feed of a radar, next to its snapshot pages.
+const feedFile = "feed.xml"
+
+// atomFeed is an Atom feed (RFC 4287) with an entry per snapshot.
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomEntry struct {
+	Title     string       `xml:"title"`
+	ID        string       `xml:"id"`
+	Updated   string       `xml:"updated"`
+	Published string       `xml:"published,omitempty"`
+	Link      atomLink     `xml:"link"`
+	Summary   *atomContent `xml:"summary,omitempty"`
+}
+
+type atomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+// buildFeed returns the feed of the snapshots, latest first.
+// Links point to baseURL + <date>.html, or are relative to the feed if baseURL is empty.
+// The entry title is the snapshot title or the radar title with the date, the summary is
+// rendered from Markdown, and the entry is updated at the publication date if set,
+// otherwise at the snapshot date.
+func buildFeed(files []core.TechnologiesFile, meta core.Meta, baseURL string, now time.Time) (atomFeed, error) {
+	if baseURL != "" && !strings.HasSuffix(baseURL, "/") {
+		baseURL += "/"
+	}
+	title := cmp.Or(meta.Title, "Technology Radar")
+	feedID := "urn:terago:" + cmp.Or(slugify(title), "radar")
+
+	feed := atomFeed{Title: title, ID: feedID}
+	if baseURL != "" {
+		feed.ID = baseURL
+		feed.Links = []atomLink{{Href: baseURL + feedFile, Rel: "self"}, {Href: baseURL}}
+	}
+
+	var latest time.Time
+	for i := len(files) - 1; i >= 0; i-- {
+		file := files[i]
+		date := snapshotDisplayDate(file, meta)
+
+		entry := atomEntry{
+			Title: cmp.Or(file.Title, title+" "+date),
+			ID:    feedID + ":" + file.Date,
+			Link:  atomLink{Href: baseURL + file.Date + ".html"},
+		}
+		if baseURL != "" {
+			entry.ID = entry.Link.Href
+		}
+
+		updated, ok := snapshotTime(file.Date, file.Time)
+		if !ok {
+			updated = now
+		}
+		published, err := file.PublishedTime()
+		if err != nil {
+			return feed, fmt.Errorf("snapshot %s: %v", file.Date, err)
+		}
+		if !published.IsZero() {
+			updated = published
+			entry.Published = published.Format(time.RFC3339)
+		}
+		entry.Updated = updated.Format(time.RFC3339)
+		if updated.After(latest) {
+			latest = updated
+		}
+
+		if file.Summary != "" {
+			summary, err := renderMarkdown(file.Summary)
+			if err != nil {
+				return feed, fmt.Errorf("snapshot %s: error rendering summary: %v", file.Date, err)
+			}
+			entry.Summary = &atomContent{Type: "html", Body: summary}
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+
+	if latest.IsZero() {
+		latest = now
+	}
+	feed.Updated = latest.Format(time.RFC3339)
+	return feed, nil
+}
+
+// writeFeed writes the feed of the snapshots to path.
+func writeFeed(path string, files []core.TechnologiesFile, meta core.Meta, baseURL string) error {
+	feed, err := buildFeed(files, meta, baseURL, time.Now())
+	if err != nil {
+		return err
+	}
+	data, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append([]byte(xml.Header), append(data, '\n')...), 0644)
+}
@@ -0,0 +1,97 @@
+package usecases
+
+import (
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+func TestBuildFeed(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	meta := core.DefaultMeta()
+	meta.Title = "Acme Radar"
+	files := []core.TechnologiesFile{
+		{Date: "20240101"},
+		{Date: "20240401", Title: "Platform consolidation", Summary: "We moved to **one** platform.", PublishedAt: "2024-04-15"},
+	}
+
+	t.Run("relative links", func(t *testing.T) {
+		feed, err := buildFeed(files, meta, "", now)
+		if err != nil {
+			t.Fatalf("buildFeed failed: %v", err)
+		}
+		if feed.Title != "Acme Radar" || feed.ID != "urn:terago:acme-radar" || feed.Updated != "2024-04-15T00:00:00Z" {
+			t.Errorf("Unexpected feed: %+v", feed)
+		}
+		if len(feed.Entries) != 2 {
+			t.Fatalf("Expected 2 entries, got %d", len(feed.Entries))
+		}
+
+		latest := feed.Entries[0]
+		if latest.Title != "Platform consolidation" || latest.Link.Href != "20240401.html" || latest.ID != "urn:terago:acme-radar:20240401" {
+			t.Errorf("Unexpected latest entry: %+v", latest)
+		}
+		if latest.Updated != "2024-04-15T00:00:00Z" || latest.Published != "2024-04-15T00:00:00Z" {
+			t.Errorf("Expected the publication date as update time, got %+v", latest)
+		}
+		if latest.Summary == nil || !strings.Contains(latest.Summary.Body, "<strong>one</strong>") {
+			t.Errorf("Expected the summary rendered to HTML, got %+v", latest.Summary)
+		}
+
+		first := feed.Entries[1]
+		if first.Title != "Acme Radar 2024-01-01" || first.Updated != "2024-01-01T00:00:00Z" || first.Summary != nil {
+			t.Errorf("Unexpected first entry: %+v", first)
+		}
+	})
+
+	t.Run("base url", func(t *testing.T) {
+		feed, err := buildFeed(files, meta, "https://radar.example.com", now)
+		if err != nil {
+			t.Fatalf("buildFeed failed: %v", err)
+		}
+		if feed.ID != "https://radar.example.com/" || len(feed.Links) != 2 || feed.Links[0].Href != "https://radar.example.com/feed.xml" {
+			t.Errorf("Unexpected feed: %+v", feed)
+		}
+		if feed.Entries[0].Link.Href != "https://radar.example.com/20240401.html" || feed.Entries[0].ID != feed.Entries[0].Link.Href {
+			t.Errorf("Expected absolute links, got %+v", feed.Entries[0])
+		}
+	})
+
+	t.Run("invalid publication date", func(t *testing.T) {
+		_, err := buildFeed([]core.TechnologiesFile{{Date: "20240101", PublishedAt: "15.01.2024"}}, meta, "", now)
+		if err == nil || !strings.Contains(err.Error(), "invalid publishedAt") {
+			t.Errorf("Expected invalid publishedAt error, got %v", err)
+		}
+	})
+}
+
+func TestGenerateRadarWritesFeed(t *testing.T) {
+	tempDir := t.TempDir()
+	files := []core.TechnologiesFile{{
+		Date:         "20240101",
+		Title:        "First radar",
+		Technologies: []core.Technology{{Name: "Go", Ring: "Adopt", Quadrant: "Languages", IsNew: true}},
+	}}
+
+	generator := GenerateRadar{OutputDir: tempDir, Files: files, Meta: core.DefaultMeta()}
+	if err := generator.Do(); err != nil {
+		t.Fatalf("GenerateRadar failed: %v", err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(tempDir, feedFile))
+	if err != nil {
+		t.Fatalf("Failed to read feed: %v", err)
+	}
+	var feed atomFeed
+	if err := xml.Unmarshal(content, &feed); err != nil {
+		t.Fatalf("Feed is not valid XML: %v", err)
+	}
+	if len(feed.Entries) != 1 || feed.Entries[0].Title != "First radar" {
+		t.Errorf("Unexpected feed entries: %+v", feed.Entries)
+	}
+}
@@ -1,6 +1,7 @@
 package usecases
 
 import (
+	"fmt"
 	"html/template"
 	"log"
 	"os"
@@ -73,6 +74,56 @@ func formatDate(dateStr string) string {
 	return dateStr[:4] + "-" + dateStr[4:6] + "-" + dateStr[6:8]
 }
 
+// snapshotDisplayDate returns the date of a snapshot for display: the snapshot time
+// formatted according to meta when known, otherwise the formatted date of the file name.
+func snapshotDisplayDate(file core.TechnologiesFile, meta core.Meta) string {
+	if !file.Time.IsZero() {
+		return meta.FormatSnapshotTime(file.Time)
+	}
+	return formatDate(file.Date)
+}
+
+// setSnapshotInfo sets the title, summary, publication date and draft flag of the snapshot.
+func setSnapshotInfo(data *core.RadarData, file core.TechnologiesFile) error {
+	published, err := file.PublishedTime()
+	if err != nil {
+		return err
+	}
+	if !published.IsZero() {
+		data.PublishedAt = published.Format("2006-01-02")
+	}
+	data.SnapshotTitle = file.Title
+	data.Draft = file.Draft
+
+	if file.Summary != "" {
+		summary, err := renderMarkdown(file.Summary)
+		if err != nil {
+			return fmt.Errorf("error rendering summary: %v", err)
+		}
+		data.SetSummary(summary)
+	}
+	return nil
+}
+
+// snapshotTime returns the time of a snapshot, parsing the date in YYYYMMDD format
+// when the time is unknown.
+func snapshotTime(date string, t time.Time) (time.Time, bool) {
+	if !t.IsZero() {
+		return t, true
+	}
+	return parseDate(date)
+}
+
+// parseDate parses a date in YYYYMMDD format.
+// Returns false if the string is not a date (e.g., for custom date formats).
+func parseDate(dateStr string) (time.Time, bool) {
+	t, err := time.Parse("20060102", dateStr)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
 // convertTechnologiesToEntries converts Technology structs to RadarEntry structs
 // If includeLinks is true, Link field will be populated based on technology name and quadrant
 // Deleted technologies (IsDeleted=true) are not converted to entries as they should not appear on the radar
@@ -96,13 +147,15 @@ func convertTechnologiesToEntries(technologies []core.Technology, meta core.Meta
 		}
 
 		entry := core.RadarEntry{
-			Quadrant:    quadrantIndex,
-			Ring:        ringIndex,
-			Moved:       moved,
-			Label:       tech.Name,
-			Link:        link,
-			Active:      false,
-			Description: tech.Description,
+			Quadrant:        quadrantIndex,
+			Ring:            ringIndex,
+			Moved:           moved,
+			Label:           tech.Name,
+			Link:            link,
+			Active:          false,
+			Description:     tech.Description,
+			DescriptionHTML: tech.DescriptionHTML,
+			Adoptions:       tech.Adoptions,
 		}
 
 		entries = append(entries, entry)
@@ -151,13 +204,67 @@ func buildChangesTable(technologies []core.Technology, meta core.Meta) string {
 			ringClass = "deleted"
 		}
 		html.WriteString("\n\t\t\t\t\t\t<td class=\"status-" + ringClass + "\">" + status + "</td>")
-		html.WriteString("\n\t\t\t\t\t\t<td>" + tech.Description + "</td>")
+		description := tech.Description
+		if tech.DescriptionHTML != "" {
+			description = tech.DescriptionHTML
+		}
+		html.WriteString("\n\t\t\t\t\t\t<td>" + description + "</td>")
 		html.WriteString("\n\t\t\t\t\t</tr>")
 	}
 
 	return html.String()
 }
 
+// parseRadarTemplate parses the radar template from the file, or the embedded template if path is empty.
+func parseRadarTemplate(path string) (*template.Template, error) {
+	templateContent := []byte(radar.HTML)
+	if path != "" {
+		var err error
+		templateContent, err = os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return template.New("radar").Parse(string(templateContent))
+}
+
+// newRadarData prepares the template data for a snapshot, without the changes table.
+func newRadarData(file core.TechnologiesFile, meta core.Meta, includeLinks, embedLibs bool) (core.RadarData, error) {
+	// Convert technologies to radar entries
+	entries := convertTechnologiesToEntries(file.Technologies, meta, includeLinks)
+
+	data := core.RadarData{
+		Title:       meta.Title,
+		Date:        snapshotDisplayDate(file, meta),
+		Version:     core.Version,
+		GeneratedAt: time.Now().Format("2006-01-02 15:04:05"),
+		Entries:     entries,
+		Quadrants:   meta.Quadrants,
+		Rings:       meta.Rings,
+	}
+	if err := setSnapshotInfo(&data, file); err != nil {
+		return data, fmt.Errorf("snapshot %s: %v", file.Date, err)
+	}
+	if err := data.UpdateJSON(); err != nil {
+		return data, err
+	}
+	// Set description JavaScript
+	data.SetDescriptionJS(radar.DescriptionJS)
+
+	// Set embedded libraries if embedLibs is true
+	if embedLibs {
+		data.SetEmbeddedLibs(radar.D3JS, radar.RadarJS)
+	}
+
+	// Rings of technologies in the merged radars of an aggregated radar
+	if radars, adoptionsHTML := buildAdoptionTable(file.Technologies); adoptionsHTML != "" {
+		data.AdoptionRadars = radars
+		data.SetAdoptionTable(adoptionsHTML)
+	}
+
+	return data, nil
+}
+
 // GenerateRadar represents the radar generation use case with all its parameters.
 type GenerateRadar struct {
 	OutputDir             string
@@ -170,6 +277,8 @@ type GenerateRadar struct {
 	AddChanges            bool
 	SkipFirstRadarChanges bool
 	EmbedLibs             bool
+	// BaseURL is the absolute URL of OutputDir for the links of the feed (optional)
+	BaseURL string
 }
 
 // Do executes the radar generation.
@@ -177,6 +286,8 @@ type GenerateRadar struct {
 // If AddChanges is true, a table with changed or new technologies will be included.
 // If SkipFirstRadarChanges is true, the changes table will be skipped for the first (earliest) radar.
 // If EmbedLibs is true, JavaScript libraries will be embedded in HTML instead of loading from CDN.
+// The Atom feed of all snapshots, feed.xml, is always regenerated: it needs no settings
+// besides the radar title, and BaseURL only makes its links absolute.
 func (g *GenerateRadar) Do() error {
 	// Create output directory if it doesn't exist
 	if _, err := os.Stat(g.OutputDir); os.IsNotExist(err) {
@@ -185,32 +296,19 @@ func (g *GenerateRadar) Do() error {
 		}
 	}
 
-	// Find the earliest date (first radar) if SkipFirstRadarChanges is enabled
+	// Find the earliest snapshot (first radar) if SkipFirstRadarChanges is enabled
 	firstRadarDate := ""
-	if g.SkipFirstRadarChanges {
-		for _, file := range g.Files {
-			if firstRadarDate == "" || file.Date < firstRadarDate {
-				firstRadarDate = file.Date
+	if g.SkipFirstRadarChanges && len(g.Files) > 0 {
+		first := g.Files[0]
+		for _, file := range g.Files[1:] {
+			if file.Before(first) {
+				first = file
 			}
 		}
+		firstRadarDate = first.Date
 	}
 
-	// read template file
-	var templateContent []byte
-	var err error
-	if g.TemplatePath == "" {
-		// Use embedded template
-		templateContent = []byte(radar.HTML)
-	} else {
-		// Read template file from disk
-		templateContent, err = os.ReadFile(g.TemplatePath)
-		if err != nil {
-			return err
-		}
-	}
-
-	// Parse template
-	tmpl, err := template.New("radar").Parse(string(templateContent))
+	tmpl, err := parseRadarTemplate(g.TemplatePath)
 	if err != nil {
 		return err
 	}
@@ -229,30 +327,10 @@ func (g *GenerateRadar) Do() error {
 			}
 		}
 
-		// Convert technologies to radar entries
-		entries := convertTechnologiesToEntries(file.Technologies, g.Meta, g.IncludeLinks)
-
-		// Prepare data for template
-		formattedDate := formatDate(file.Date)
-		data := core.RadarData{
-			Title:       g.Meta.Title,
-			Date:        formattedDate,
-			Version:     core.Version,
-			GeneratedAt: time.Now().Format("2006-01-02 15:04:05"),
-			Entries:     entries,
-			Quadrants:   g.Meta.Quadrants,
-			Rings:       g.Meta.Rings,
-		}
-		if err := data.UpdateJSON(); err != nil {
+		data, err := newRadarData(file, g.Meta, g.IncludeLinks, g.EmbedLibs)
+		if err != nil {
 			return err
 		}
-		// Set description JavaScript
-		data.SetDescriptionJS(radar.DescriptionJS)
-
-		// Set embedded libraries if EmbedLibs is true
-		if g.EmbedLibs {
-			data.SetEmbeddedLibs(radar.D3JS, radar.RadarJS)
-		}
 
 		// Build and set changes table if AddChanges is true
 		// Skip changes table for the first radar (earliest date) if SkipFirstRadarChanges is enabled
@@ -279,5 +357,12 @@ func (g *GenerateRadar) Do() error {
 		}
 	}
 
+	if err := writeFeed(filepath.Join(g.OutputDir, feedFile), g.Files, g.Meta, g.BaseURL); err != nil {
+		return err
+	}
+	if g.Verbose {
+		log.Printf("Generated %s", feedFile)
+	}
+
 	return nil
 }
@@ -220,6 +220,32 @@ func TestFormatDate(t *testing.T) {
 	}
 }
 
+func TestSnapshotDisplayDate(t *testing.T) {
+	meta := core.DefaultMeta()
+	if got := snapshotDisplayDate(core.TechnologiesFile{Date: "20240115"}, meta); got != "2024-01-15" {
+		t.Errorf("Expected the formatted file name date, got %q", got)
+	}
+
+	meta.DateLayout = core.DateLayoutQuarter
+	file := core.TechnologiesFile{Date: "radar-2024-Q3", Time: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)}
+	if got := snapshotDisplayDate(file, meta); got != "2024 Q3" {
+		t.Errorf("Expected the snapshot time formatted by meta, got %q", got)
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	date, ok := parseDate("20231201")
+	if !ok || !date.Equal(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("parseDate(\"20231201\") = %v, %v", date, ok)
+	}
+
+	for _, input := range []string{"", "2023-12-01", "radar-20231201", "20231301"} {
+		if _, ok := parseDate(input); ok {
+			t.Errorf("parseDate(%q) should fail", input)
+		}
+	}
+}
+
 func TestGenerateRadarWithForce(t *testing.T) {
 	// Create temporary directory for testing
 	tempDir, err := os.MkdirTemp("", "terago_test")
@@ -562,6 +588,15 @@ func TestBuildChangesTable(t *testing.T) {
 			wantContains: []string{"React", "MOVED", "Adopt", "Trial", "UI library"},
 			wantEmpty:    false,
 		},
+		{
+			name: "with Markdown description",
+			technologies: []core.Technology{
+				{Name: "Go", Ring: "Adopt", Quadrant: "Languages", Description: "**Fast** language",
+					DescriptionHTML: "<p><strong>Fast</strong> language</p>\n", IsNew: true},
+			},
+			wantContains: []string{"<td><p><strong>Fast</strong> language</p>\n</td>"},
+			wantEmpty:    false,
+		},
 		{
 			name: "with deleted technology",
 			technologies: []core.Technology{
@@ -775,3 +810,108 @@ func TestConvertTechnologiesToEntries(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateRadarWithSnapshotTime(t *testing.T) {
+	tempDir := t.TempDir()
+	meta := core.DefaultMeta()
+	meta.DateLayout = core.DateLayoutQuarter
+
+	tech := []core.Technology{{Name: "Go", Ring: "Adopt", Quadrant: "Languages", Description: "Go", IsNew: true}}
+	// Files are not in file name order, the first radar is found by time
+	files := []core.TechnologiesFile{
+		{Date: "radar-Q1-2024", Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Technologies: tech},
+		{Date: "radar-Q4-2023", Time: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), Technologies: tech},
+	}
+
+	generator := GenerateRadar{
+		OutputDir:             tempDir,
+		Files:                 files,
+		Meta:                  meta,
+		AddChanges:            true,
+		SkipFirstRadarChanges: true,
+	}
+	if err := generator.Do(); err != nil {
+		t.Fatalf("GenerateRadar failed: %v", err)
+	}
+
+	first, err := os.ReadFile(filepath.Join(tempDir, "radar-Q4-2023.html"))
+	if err != nil {
+		t.Fatalf("Failed to read output file: %v", err)
+	}
+	if strings.Contains(string(first), "Changes in this Radar") {
+		t.Error("Earliest radar should not contain changes table")
+	}
+	if !strings.Contains(string(first), "2023 Q4") {
+		t.Error("Expected quarter in the radar date")
+	}
+
+	second, err := os.ReadFile(filepath.Join(tempDir, "radar-Q1-2024.html"))
+	if err != nil {
+		t.Fatalf("Failed to read output file: %v", err)
+	}
+	if !strings.Contains(string(second), "Changes in this Radar") {
+		t.Error("Later radar should contain changes table")
+	}
+}
+
+func TestGenerateRadarWithSnapshotInfo(t *testing.T) {
+	tech := []core.Technology{{Name: "Go", Ring: "Adopt", Quadrant: "Languages", Description: "Go", IsNew: true}}
+
+	t.Run("title, summary and publication date are shown", func(t *testing.T) {
+		tempDir := t.TempDir()
+		files := []core.TechnologiesFile{{
+			Date:         "20240101",
+			Title:        "Platform consolidation",
+			Summary:      "We moved to **one** platform.\n\n<script>alert(1)</script>",
+			PublishedAt:  "2024-01-15",
+			Technologies: tech,
+		}}
+
+		generator := GenerateRadar{OutputDir: tempDir, Files: files, Meta: core.DefaultMeta()}
+		if err := generator.Do(); err != nil {
+			t.Fatalf("GenerateRadar failed: %v", err)
+		}
+
+		content, err := os.ReadFile(filepath.Join(tempDir, "20240101.html"))
+		if err != nil {
+			t.Fatalf("Failed to read output file: %v", err)
+		}
+		html := string(content)
+		for _, expected := range []string{"Platform consolidation", "Published 2024-01-15", "<strong>one</strong>"} {
+			if !strings.Contains(html, expected) {
+				t.Errorf("Expected %q in the radar", expected)
+			}
+		}
+		if strings.Contains(html, "<script>alert(1)</script>") {
+			t.Error("Raw HTML in the summary should not be rendered")
+		}
+	})
+
+	t.Run("draft is marked", func(t *testing.T) {
+		tempDir := t.TempDir()
+		files := []core.TechnologiesFile{{Date: "20240101", Draft: true, Technologies: tech}}
+
+		generator := GenerateRadar{OutputDir: tempDir, Files: files, Meta: core.DefaultMeta()}
+		if err := generator.Do(); err != nil {
+			t.Fatalf("GenerateRadar failed: %v", err)
+		}
+
+		content, err := os.ReadFile(filepath.Join(tempDir, "20240101.html"))
+		if err != nil {
+			t.Fatalf("Failed to read output file: %v", err)
+		}
+		if !strings.Contains(string(content), "Draft, not published yet") {
+			t.Error("Expected the draft to be marked")
+		}
+	})
+
+	t.Run("invalid publication date", func(t *testing.T) {
+		files := []core.TechnologiesFile{{Date: "20240101", PublishedAt: "15.01.2024", Technologies: tech}}
+
+		generator := GenerateRadar{OutputDir: t.TempDir(), Files: files, Meta: core.DefaultMeta()}
+		err := generator.Do()
+		if err == nil || !strings.Contains(err.Error(), "invalid publishedAt") {
+			t.Errorf("Expected invalid publishedAt error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,203 @@
+package usecases
+
+import (
+	"cmp"
+	"fmt"
+	"html/template"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ekalinin/terago/pkg/core"
+	"github.com/ekalinin/terago/pkg/radar"
+)
+
+// workspaceIndexFile is the name of the combined index page of a workspace.
+const workspaceIndexFile = "index.html"
+
+// GenerateWorkspace represents the generation of all radars of a workspace
+// and of the index page linking them.
+type GenerateWorkspace struct {
+	Workspace core.Workspace
+	OutputDir string
+	// Radar holds the options shared by all radars. OutputDir, Files and Meta
+	// are set per radar, TemplatePath is used for radars without a template.
+	Radar GenerateRadar
+	// Options of reading the radars, Lenient also allows unknown keys in meta files
+	Options ReadOptions
+}
+
+// Do generates each radar into its output sub-path of OutputDir, the aggregated radar
+// if the workspace has one, and index.html with all radars and their snapshots.
+// The index and the aggregated radar are always regenerated.
+func (g *GenerateWorkspace) Do() error {
+	index := core.IndexData{
+		Title:       cmp.Or(g.Workspace.Title, "Technology Radars"),
+		Version:     core.Version,
+		GeneratedAt: time.Now().Format("2006-01-02 15:04:05"),
+	}
+
+	latest := make(map[string]AggregateSource)
+	for _, r := range g.Workspace.Radars {
+		indexRadar, source, err := g.generateRadar(r)
+		if err != nil {
+			return fmt.Errorf("radar '%s': %v", r.Name, err)
+		}
+		index.Radars = append(index.Radars, indexRadar)
+		latest[r.Name] = source
+	}
+
+	if a := g.Workspace.Aggregate; a != nil {
+		indexRadar, err := g.generateAggregate(latest)
+		if err != nil {
+			return fmt.Errorf("aggregate '%s': %v", a.AggregateName(), err)
+		}
+		index.Radars = append(index.Radars, indexRadar)
+	}
+
+	tmpl, err := template.New("index").Parse(radar.IndexHTML)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(g.OutputDir, 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(filepath.Join(g.OutputDir, workspaceIndexFile))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := tmpl.Execute(f, index); err != nil {
+		return err
+	}
+
+	if g.Radar.Verbose {
+		log.Printf("Generated %s", workspaceIndexFile)
+	}
+	return nil
+}
+
+// generateRadar generates a single radar of the workspace and returns its index entry
+// and its latest snapshot.
+func (g *GenerateWorkspace) generateRadar(r core.WorkspaceRadar) (core.IndexRadar, AggregateSource, error) {
+	source := AggregateSource{Name: r.Name}
+
+	meta, err := ReadMeta(r.Meta, r.Input, g.Radar.Verbose, g.Options.Lenient)
+	if err != nil {
+		return core.IndexRadar{}, source, err
+	}
+	source.Meta = meta
+
+	files, err := ReadTechnologiesFiles(r.Input, meta, g.Options)
+	if err != nil {
+		return core.IndexRadar{}, source, err
+	}
+	if len(files) > 0 {
+		source.File = files[len(files)-1]
+	}
+
+	generator := g.Radar
+	generator.OutputDir = filepath.Join(g.OutputDir, r.OutputPath())
+	generator.TemplatePath = cmp.Or(r.Template, g.Radar.TemplatePath)
+	generator.Files = files
+	generator.Meta = meta
+	generator.BaseURL = g.radarURL(r.OutputPath())
+	if err := generator.Do(); err != nil {
+		return core.IndexRadar{}, source, err
+	}
+
+	indexRadar, err := indexRadar(r.Name, r.OutputPath(), meta, files)
+	return indexRadar, source, err
+}
+
+// generateAggregate merges the latest snapshots of the radars and generates the aggregated radar.
+func (g *GenerateWorkspace) generateAggregate(latest map[string]AggregateSource) (core.IndexRadar, error) {
+	a := g.Workspace.Aggregate
+	merged := g.Workspace.MergedRadars()
+
+	var sources []AggregateSource
+	for _, r := range merged {
+		sources = append(sources, latest[r.Name])
+	}
+
+	// The metas of the merged radars are not merged: the aggregated radar uses the rings,
+	// quadrants and other settings of the first merged radar unless it has its own meta.
+	// Rings and quadrants of the other radars are mapped to them by name or alias.
+	meta := sources[0].Meta
+	meta.Title, meta.Description = a.AggregateName(), ""
+	if a.Meta != "" {
+		var err error
+		meta, err = ReadMeta(a.Meta, "", g.Radar.Verbose, g.Options.Lenient)
+		if err != nil {
+			return core.IndexRadar{}, err
+		}
+	}
+	meta.Title = cmp.Or(a.Title, meta.Title)
+
+	file, err := AggregateRadars(sources, meta, a.AggregateStrategy(), a.Owner)
+	if err != nil {
+		return core.IndexRadar{}, err
+	}
+	var files []core.TechnologiesFile
+	if file.Date != "" {
+		files = append(files, file)
+	}
+
+	generator := g.Radar
+	generator.OutputDir = filepath.Join(g.OutputDir, a.OutputPath())
+	generator.TemplatePath = cmp.Or(a.Template, g.Radar.TemplatePath)
+	generator.Files = files
+	generator.Meta = meta
+	generator.BaseURL = g.radarURL(a.OutputPath())
+	generator.Force = true
+	generator.AddChanges = false
+	if err := generator.Do(); err != nil {
+		return core.IndexRadar{}, err
+	}
+
+	return indexRadar(a.AggregateName(), a.OutputPath(), meta, files)
+}
+
+// radarURL returns the absolute URL of a radar output path, empty if the base URL is not set.
+func (g *GenerateWorkspace) radarURL(outputPath string) string {
+	if g.Radar.BaseURL == "" {
+		return ""
+	}
+	return strings.TrimSuffix(g.Radar.BaseURL, "/") + "/" + filepath.ToSlash(filepath.Clean(outputPath)) + "/"
+}
+
+// indexRadar returns the index entry of a radar with its snapshots, latest first.
+func indexRadar(name, outputPath string, meta core.Meta, files []core.TechnologiesFile) (core.IndexRadar, error) {
+	path := filepath.ToSlash(filepath.Clean(outputPath))
+	indexRadar := core.IndexRadar{
+		Name:        name,
+		Title:       cmp.Or(meta.Title, name),
+		Description: meta.Description,
+		Path:        path,
+		Feed:        path + "/" + feedFile,
+	}
+	for i := len(files) - 1; i >= 0; i-- {
+		file := files[i]
+		date := snapshotDisplayDate(file, meta)
+		snapshot := core.IndexSnapshot{
+			Date:        date,
+			Title:       file.Title,
+			PublishedAt: file.PublishedAt,
+			Draft:       file.Draft,
+			Link:        path + "/" + file.Date + ".html",
+		}
+		if file.Summary != "" {
+			summary, err := renderMarkdown(file.Summary)
+			if err != nil {
+				return indexRadar, fmt.Errorf("snapshot %s: error rendering summary: %v", file.Date, err)
+			}
+			snapshot.Summary = template.HTML(summary)
+		}
+		indexRadar.Snapshots = append(indexRadar.Snapshots, snapshot)
+	}
+	return indexRadar, nil
+}
@@ -0,0 +1,189 @@
+package usecases
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerateWorkspace(t *testing.T) {
+	baseDir := t.TempDir()
+	outputDir := filepath.Join(t.TempDir(), "public")
+
+	files := map[string]string{
+		"terago.yaml": `title: "Acme Radars"
+radars:
+  - name: company
+    input: company
+  - name: data
+    input: teams/data
+    output: teams/data
+aggregate:
+  name: company-view
+  title: "Company View"
+  strategy: conservative
+`,
+		"company/meta.yaml": `title: "Company Radar"
+description: "Technologies used across the company"
+quadrants:
+  - name: Languages
+    alias: languages
+  - name: Platforms
+    alias: platforms
+  - name: Tools
+    alias: tools
+  - name: Techniques
+    alias: techniques
+rings:
+  - name: Adopt
+    alias: adopt
+  - name: Trial
+    alias: trial
+  - name: Assess
+    alias: assess
+  - name: Hold
+    alias: hold
+`,
+		"company/20240101.yaml": `technologies:
+  - name: Go
+    ring: Adopt
+    quadrant: Languages
+    description: Programming language
+`,
+		"company/20240401.yaml": `title: "Platform consolidation"
+summary: "All services moved to **one** platform"
+technologies:
+  - name: Go
+    ring: Adopt
+    quadrant: Languages
+    description: Programming language
+`,
+		"teams/data/20240201.yaml": `technologies:
+  - name: Spark
+    ring: Trial
+    quadrant: Platforms
+    description: Data processing
+  - name: Go
+    ring: Hold
+    quadrant: Languages
+    description: Go at data
+`,
+	}
+	for name, content := range files {
+		path := filepath.Join(baseDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+	}
+
+	workspace, err := ReadWorkspace(filepath.Join(baseDir, "terago.yaml"), false)
+	if err != nil {
+		t.Fatalf("ReadWorkspace failed: %v", err)
+	}
+	if workspace.Radars[1].Input != filepath.Join(baseDir, "teams/data") {
+		t.Errorf("Expected input relative to the workspace file, got %s", workspace.Radars[1].Input)
+	}
+
+	generator := GenerateWorkspace{Workspace: workspace, OutputDir: outputDir}
+	if err := generator.Do(); err != nil {
+		t.Fatalf("GenerateWorkspace failed: %v", err)
+	}
+
+	for _, name := range []string{"company/20240101.html", "company/20240401.html", "company/feed.xml", "teams/data/20240201.html", "company-view/20240401.html"} {
+		if _, err := os.Stat(filepath.Join(outputDir, name)); err != nil {
+			t.Errorf("Expected generated radar %s: %v", name, err)
+		}
+	}
+
+	content, err := os.ReadFile(filepath.Join(outputDir, "index.html"))
+	if err != nil {
+		t.Fatalf("Failed to read index: %v", err)
+	}
+	index := string(content)
+	for _, expected := range []string{
+		"Acme Radars",
+		"Company Radar",
+		"Technologies used across the company",
+		`href="company/20240401.html"`,
+		"Platform consolidation",
+		"<strong>one</strong>",
+		`href="company/feed.xml"`,
+		`href="teams/data/20240201.html"`,
+		"Company View",
+		`href="company-view/20240401.html"`,
+	} {
+		if !strings.Contains(index, expected) {
+			t.Errorf("Expected %q in the index", expected)
+		}
+	}
+	if strings.Index(index, "20240401.html") > strings.Index(index, "20240101.html") {
+		t.Error("Expected the latest snapshot first")
+	}
+
+	content, err = os.ReadFile(filepath.Join(outputDir, "company-view/20240401.html"))
+	if err != nil {
+		t.Fatalf("Failed to read aggregated radar: %v", err)
+	}
+	aggregated := string(content)
+	for _, expected := range []string{"Rings by Radar", "<th>company</th>", "<th>data</th>", `"adoptions":[{"radar":"company","ring":"Adopt"},{"radar":"data","ring":"Hold"}]`} {
+		if !strings.Contains(aggregated, expected) {
+			t.Errorf("Expected %q in the aggregated radar", expected)
+		}
+	}
+}
+
+func TestReadWorkspaceUnknownKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "terago.yaml")
+	if err := os.WriteFile(path, []byte("radars:\n  - name: company\n    inptu: company\n"), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	_, err := ReadWorkspace(path, false)
+	if err == nil || !strings.Contains(err.Error(), "inptu") {
+		t.Errorf("Expected unknown key error, got %v", err)
+	}
+}
+
+func TestGenerateWorkspaceDrafts(t *testing.T) {
+	baseDir := t.TempDir()
+	for name, content := range map[string]string{
+		"terago.yaml":           "radars:\n  - name: company\n    input: company\n",
+		"company/20240101.yaml": "technologies:\n  - name: Go\n    ring: Adopt\n    quadrant: Languages\n",
+		"company/20240401.yaml": "draft: true\ntechnologies:\n  - name: Go\n    ring: Adopt\n    quadrant: Languages\n",
+	} {
+		path := filepath.Join(baseDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+	}
+
+	workspace, err := ReadWorkspace(filepath.Join(baseDir, "terago.yaml"), false)
+	if err != nil {
+		t.Fatalf("ReadWorkspace failed: %v", err)
+	}
+
+	for _, includeDrafts := range []bool{false, true} {
+		outputDir := t.TempDir()
+		generator := GenerateWorkspace{Workspace: workspace, OutputDir: outputDir, Options: ReadOptions{IncludeDrafts: includeDrafts}}
+		if err := generator.Do(); err != nil {
+			t.Fatalf("GenerateWorkspace failed: %v", err)
+		}
+
+		content, err := os.ReadFile(filepath.Join(outputDir, "index.html"))
+		if err != nil {
+			t.Fatalf("Failed to read index: %v", err)
+		}
+		index := string(content)
+		hasDraft := strings.Contains(index, "company/20240401.html") && strings.Contains(index, "[draft]")
+		if hasDraft != includeDrafts {
+			t.Errorf("With includeDrafts=%v expected draft in the index: %v, got:\n%s", includeDrafts, includeDrafts, index)
+		}
+	}
+}
@@ -0,0 +1,347 @@
+package usecases
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+// zalandoQuadrant represents a quadrant in the Zalando tech-radar config
+type zalandoQuadrant struct {
+	Name string `json:"name"`
+}
+
+// zalandoRing represents a ring in the Zalando tech-radar config
+type zalandoRing struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// zalandoEntry represents a single entry in the Zalando tech-radar config
+type zalandoEntry struct {
+	Label       string `json:"label"`
+	Quadrant    int    `json:"quadrant"`
+	Ring        int    `json:"ring"`
+	Moved       int    `json:"moved"`
+	Link        string `json:"link"`
+	Active      bool   `json:"active"`
+	Description string `json:"description"`
+}
+
+// technologiesOutput is the on-disk representation of a technologies file.
+// The date is not stored in the file, it is taken from the file name.
+type technologiesOutput struct {
+	Technologies []core.Technology `yaml:"technologies"`
+}
+
+// ImportZalando represents the import of a Zalando tech-radar config
+// (radar_visualization call or config.json) into terago meta and snapshot files.
+type ImportZalando struct {
+	InputPath string
+	OutputDir string
+	Date      string
+	Force     bool
+}
+
+// Do executes the import.
+// It writes meta.yaml and <Date>.yaml into OutputDir.
+// Existing files are not overwritten unless Force is true.
+func (i *ImportZalando) Do() error {
+	datePattern := regexp.MustCompile(core.DefaultMeta().FileNamePattern)
+	if !datePattern.MatchString(i.Date + ".yaml") {
+		return fmt.Errorf("invalid date '%s': expected YYYYMMDD format", i.Date)
+	}
+
+	data, err := os.ReadFile(i.InputPath)
+	if err != nil {
+		return fmt.Errorf("error reading file: %v", err)
+	}
+
+	metaFile, technologies, err := parseZalandoConfig(string(data))
+	if err != nil {
+		return err
+	}
+
+	metaData, err := marshalYAML(metaFile)
+	if err != nil {
+		return err
+	}
+
+	techData, err := marshalYAML(technologiesOutput{Technologies: technologies})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(i.OutputDir, 0755); err != nil {
+		return err
+	}
+
+	for _, f := range []struct {
+		path string
+		data []byte
+	}{
+		{filepath.Join(i.OutputDir, "meta.yaml"), metaData},
+		{filepath.Join(i.OutputDir, i.Date+".yaml"), techData},
+	} {
+		err := writeNewFile(f.path, f.data, i.Force)
+		if errors.Is(err, errFileExists) {
+			return fmt.Errorf("%v (use --force to overwrite)", err)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// errFileExists is returned by writeNewFile for an existing file.
+var errFileExists = errors.New("already exists")
+
+// writeNewFile writes data to the file, refusing to overwrite an existing file unless force is true.
+// The file is created exclusively, so an existing file is never touched without force.
+func writeNewFile(filePath string, data []byte, force bool) error {
+	flag := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if !force {
+		flag |= os.O_EXCL
+	}
+	f, err := os.OpenFile(filePath, flag, 0644)
+	if os.IsExist(err) {
+		return fmt.Errorf("file %s %w", filePath, errFileExists)
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// parseZalandoConfig extracts quadrants, rings and entries from a Zalando
+// tech-radar config and converts them to terago meta and technologies.
+// Entry quadrant and ring indices are mapped to names using the source's arrays.
+func parseZalandoConfig(src string) (core.MetaFile, []core.Technology, error) {
+	var metaFile core.MetaFile
+	var quadrants []zalandoQuadrant
+	var rings []zalandoRing
+	var entries []zalandoEntry
+
+	if err := decodeJSArray(src, "quadrants", &quadrants); err != nil {
+		return metaFile, nil, err
+	}
+	if err := decodeJSArray(src, "rings", &rings); err != nil {
+		return metaFile, nil, err
+	}
+	if err := decodeJSArray(src, "entries", &entries); err != nil {
+		return metaFile, nil, err
+	}
+
+	metaFile.Title = extractJSString(src, "title")
+	for _, q := range quadrants {
+		metaFile.Quadrants = append(metaFile.Quadrants, core.Quadrant{Name: q.Name, Alias: slugify(q.Name)})
+	}
+	for _, r := range rings {
+		metaFile.Rings = append(metaFile.Rings, core.Ring{Name: r.Name, Alias: slugify(r.Name)})
+	}
+
+	technologies := make([]core.Technology, 0, len(entries))
+	for _, e := range entries {
+		if e.Quadrant < 0 || e.Quadrant >= len(quadrants) {
+			return metaFile, nil, fmt.Errorf("entry '%s' has unknown quadrant index %d", e.Label, e.Quadrant)
+		}
+		if e.Ring < 0 || e.Ring >= len(rings) {
+			return metaFile, nil, fmt.Errorf("entry '%s' has unknown ring index %d", e.Label, e.Ring)
+		}
+
+		// Zalando entries usually have no description, but terago requires one
+		description := e.Description
+		if description == "" {
+			description = e.Label
+		}
+
+		technologies = append(technologies, core.Technology{
+			Name:        e.Label,
+			Ring:        rings[e.Ring].Name,
+			Quadrant:    quadrants[e.Quadrant].Name,
+			Description: description,
+			Info:        e.Link,
+		})
+	}
+
+	return metaFile, technologies, nil
+}
+
+// decodeJSArray finds the array assigned to key (e.g. `entries: [...]`,
+// `"entries": [...]` or `var entries = [...]`) and decodes it into v.
+func decodeJSArray(src, key string, v interface{}) error {
+	re := regexp.MustCompile(`["']?\b` + regexp.QuoteMeta(key) + `\b["']?\s*[:=]\s*\[`)
+	loc := re.FindStringIndex(src)
+	if loc == nil {
+		return fmt.Errorf("no '%s' array found in input", key)
+	}
+
+	start := loc[1] - 1
+	end, err := findClosingBracket(src, start)
+	if err != nil {
+		return fmt.Errorf("error parsing '%s': %v", key, err)
+	}
+
+	if err := json.Unmarshal([]byte(jsToJSON(src[start:end+1])), v); err != nil {
+		return fmt.Errorf("error parsing '%s': %v", key, err)
+	}
+	return nil
+}
+
+// extractJSString returns the string value assigned to key, or empty string if not found.
+func extractJSString(src, key string) string {
+	re := regexp.MustCompile(`["']?\b` + regexp.QuoteMeta(key) + `\b["']?\s*:\s*(?:"([^"]*)"|'([^']*)')`)
+	m := re.FindStringSubmatch(src)
+	if m == nil {
+		return ""
+	}
+	return m[1] + m[2]
+}
+
+// findClosingBracket returns the index of the bracket closing the one at start.
+// Strings and comments are skipped.
+func findClosingBracket(src string, start int) (int, error) {
+	depth := 0
+	for i := start; i < len(src); i++ {
+		switch c := src[i]; c {
+		case '"', '\'', '`':
+			i = skipJSString(src, i)
+		case '/':
+			i = skipJSComment(src, i)
+		case '[', '{':
+			depth++
+		case ']', '}':
+			depth--
+			if depth == 0 {
+				return i, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("unterminated array")
+}
+
+// skipJSString returns the index of the closing quote of the string starting at i.
+func skipJSString(src string, i int) int {
+	quote := src[i]
+	for i++; i < len(src); i++ {
+		if src[i] == '\\' {
+			i++
+			continue
+		}
+		if src[i] == quote {
+			return i
+		}
+	}
+	return len(src)
+}
+
+// skipJSComment returns the index of the last character of the comment starting at i.
+// If there is no comment at i, i is returned unchanged.
+func skipJSComment(src string, i int) int {
+	if i+1 >= len(src) {
+		return i
+	}
+	switch src[i+1] {
+	case '/':
+		if end := strings.IndexByte(src[i:], '\n'); end >= 0 {
+			return i + end
+		}
+		return len(src)
+	case '*':
+		if end := strings.Index(src[i+2:], "*/"); end >= 0 {
+			return i + 2 + end + 1
+		}
+		return len(src)
+	}
+	return i
+}
+
+// jsToJSON converts a JavaScript object/array literal to JSON:
+// comments are removed, keys and single-quoted strings are double-quoted,
+// trailing commas are dropped and undefined becomes null.
+func jsToJSON(src string) string {
+	var out strings.Builder
+	identRe := regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*`)
+
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		switch {
+		case c == '"' || c == '\'' || c == '`':
+			end := skipJSString(src, i)
+			if end >= len(src) {
+				end = len(src) - 1
+			}
+			value := unescapeJSString(src[i+1 : end])
+			quoted, _ := json.Marshal(value)
+			out.Write(quoted)
+			i = end
+		case c == '/' && skipJSComment(src, i) != i:
+			i = skipJSComment(src, i)
+		case c == ']' || c == '}':
+			trimmed := strings.TrimRight(out.String(), " \t\r\n")
+			if strings.HasSuffix(trimmed, ",") {
+				out.Reset()
+				out.WriteString(strings.TrimSuffix(trimmed, ","))
+			}
+			out.WriteByte(c)
+		case identRe.MatchString(src[i:]):
+			ident := identRe.FindString(src[i:])
+			rest := strings.TrimLeft(src[i+len(ident):], " \t\r\n")
+			switch {
+			case strings.HasPrefix(rest, ":"):
+				out.WriteString(`"` + ident + `"`)
+			case ident == "undefined":
+				out.WriteString("null")
+			default:
+				out.WriteString(ident)
+			}
+			i += len(ident) - 1
+		default:
+			out.WriteByte(c)
+		}
+	}
+
+	return out.String()
+}
+
+// unescapeJSString resolves the escape sequences of a JavaScript string body.
+func unescapeJSString(s string) string {
+	var out strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 >= len(s) {
+			out.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			out.WriteByte('\n')
+		case 't':
+			out.WriteByte('\t')
+		case 'r':
+			out.WriteByte('\r')
+		default:
+			out.WriteByte(s[i])
+		}
+	}
+	return out.String()
+}
+
+// slugify converts a name to a lowercase alias (e.g. "Tools & Platforms" -> "tools-platforms").
+func slugify(name string) string {
+	parts := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9')
+	})
+	return strings.Join(parts, "-")
+}
@@ -0,0 +1,173 @@
+package usecases
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+const zalandoJS = `
+radar_visualization({
+  svg_id: "radar",
+  title: "Company Radar",
+  date: "2024.01",
+  quadrants: [
+    { name: "Languages" },
+    { name: "Infrastructure" },
+    { name: "Datastores" },
+    { name: "Data Management" },
+  ],
+  rings: [
+    { name: "ADOPT", color: "#5ba300" },
+    { name: "TRIAL", color: "#009eb0" },
+    { name: "ASSESS", color: "#c7ba00" },
+    { name: "HOLD", color: "#e09b96" }
+  ],
+  // entries are maintained by hand
+  entries: [
+    {
+      label: 'Go',
+      quadrant: 0,
+      ring: 0,
+      moved: 0,
+      link: "https://go.dev",
+      active: true
+    },
+    /* not decided yet */
+    { label: "Kafka", quadrant: 3, ring: 2, moved: 1, description: "Event streaming", },
+  ]
+});
+`
+
+func TestParseZalandoConfig(t *testing.T) {
+	t.Run("javascript config", func(t *testing.T) {
+		metaFile, technologies, err := parseZalandoConfig(zalandoJS)
+		if err != nil {
+			t.Fatalf("parseZalandoConfig failed: %v", err)
+		}
+
+		if metaFile.Title != "Company Radar" {
+			t.Errorf("Expected title 'Company Radar', got '%s'", metaFile.Title)
+		}
+
+		if len(metaFile.Quadrants) != 4 {
+			t.Fatalf("Expected 4 quadrants, got %d", len(metaFile.Quadrants))
+		}
+		if metaFile.Quadrants[3] != (core.Quadrant{Name: "Data Management", Alias: "data-management"}) {
+			t.Errorf("Unexpected quadrant: %+v", metaFile.Quadrants[3])
+		}
+
+		if len(metaFile.Rings) != 4 {
+			t.Fatalf("Expected 4 rings, got %d", len(metaFile.Rings))
+		}
+		if metaFile.Rings[0] != (core.Ring{Name: "ADOPT", Alias: "adopt"}) {
+			t.Errorf("Unexpected ring: %+v", metaFile.Rings[0])
+		}
+
+		expected := []core.Technology{
+			{Name: "Go", Ring: "ADOPT", Quadrant: "Languages", Description: "Go", Info: "https://go.dev"},
+			{Name: "Kafka", Ring: "ASSESS", Quadrant: "Data Management", Description: "Event streaming"},
+		}
+		if len(technologies) != len(expected) {
+			t.Fatalf("Expected %d technologies, got %d", len(expected), len(technologies))
+		}
+		for i, tech := range technologies {
+			if !reflect.DeepEqual(tech, expected[i]) {
+				t.Errorf("Technology %d: expected %+v, got %+v", i, expected[i], tech)
+			}
+		}
+	})
+
+	t.Run("json config", func(t *testing.T) {
+		src := `{
+  "quadrants": [{"name": "Languages"}, {"name": "Tools"}],
+  "rings": [{"name": "Adopt"}, {"name": "Hold"}],
+  "entries": [{"label": "Make", "quadrant": 1, "ring": 1}]
+}`
+		_, technologies, err := parseZalandoConfig(src)
+		if err != nil {
+			t.Fatalf("parseZalandoConfig failed: %v", err)
+		}
+
+		if len(technologies) != 1 || technologies[0].Quadrant != "Tools" || technologies[0].Ring != "Hold" {
+			t.Errorf("Unexpected technologies: %+v", technologies)
+		}
+	})
+
+	t.Run("entries defined as variable", func(t *testing.T) {
+		src := `var entries = [{label: "Go", quadrant: 0, ring: 0}];
+radar_visualization({quadrants: [{name: "Languages"}], rings: [{name: "Adopt"}], entries: entries});`
+		_, technologies, err := parseZalandoConfig(src)
+		if err != nil {
+			t.Fatalf("parseZalandoConfig failed: %v", err)
+		}
+
+		if len(technologies) != 1 || technologies[0].Name != "Go" {
+			t.Errorf("Unexpected technologies: %+v", technologies)
+		}
+	})
+
+	t.Run("index out of range", func(t *testing.T) {
+		src := `{quadrants: [{name: "Languages"}], rings: [{name: "Adopt"}], entries: [{label: "Go", quadrant: 4, ring: 0}]}`
+		if _, _, err := parseZalandoConfig(src); err == nil {
+			t.Error("Expected error for unknown quadrant index, got nil")
+		}
+	})
+
+	t.Run("missing rings", func(t *testing.T) {
+		src := `{quadrants: [{name: "Languages"}], entries: []}`
+		if _, _, err := parseZalandoConfig(src); err == nil {
+			t.Error("Expected error for missing rings, got nil")
+		}
+	})
+}
+
+func TestImportZalando(t *testing.T) {
+	tmpDir := t.TempDir()
+	inputPath := filepath.Join(tmpDir, "radar.js")
+	if err := os.WriteFile(inputPath, []byte(zalandoJS), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	outputDir := filepath.Join(tmpDir, "data")
+	importer := ImportZalando{
+		InputPath: inputPath,
+		OutputDir: outputDir,
+		Date:      "20240101",
+	}
+	if err := importer.Do(); err != nil {
+		t.Fatalf("ImportZalando failed: %v", err)
+	}
+
+	// Imported files should be readable and valid
+	meta, err := ReadMeta("", outputDir, false, false)
+	if err != nil {
+		t.Fatalf("ReadMeta failed: %v", err)
+	}
+	if meta.Title != "Company Radar" {
+		t.Errorf("Expected title 'Company Radar', got '%s'", meta.Title)
+	}
+
+	if err := ValidateTechnologiesFile(filepath.Join(outputDir, "20240101.yaml"), meta); err != nil {
+		t.Errorf("Imported file is not valid: %v", err)
+	}
+
+	// Second import should refuse to overwrite existing files
+	if err := importer.Do(); err == nil {
+		t.Error("Expected error when files already exist, got nil")
+	}
+
+	importer.Force = true
+	if err := importer.Do(); err != nil {
+		t.Errorf("Expected no error with Force, got: %v", err)
+	}
+
+	// Invalid date
+	importer.Date = "2024-01-01"
+	if err := importer.Do(); err == nil {
+		t.Error("Expected error for invalid date, got nil")
+	}
+}
@@ -0,0 +1,168 @@
+package usecases
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ekalinin/terago/pkg/core"
+	"github.com/ekalinin/terago/pkg/radar"
+)
+
+// ciWorkflow is a GitHub Actions workflow that validates and generates the radar.
+const ciWorkflow = `name: Radar
+on:
+  push:
+    branches:
+      - main
+  pull_request:
+
+jobs:
+  radar:
+    runs-on: ubuntu-latest
+    steps:
+      - name: Checkout
+        uses: actions/checkout@v4
+
+      - name: Set up Go
+        uses: actions/setup-go@v5
+        with:
+          go-version: stable
+
+      - name: Install terago
+        run: go install github.com/ekalinin/terago/cmd/terago@latest
+
+      - name: Validate
+        run: terago validate --input .
+
+      - name: Generate
+        run: terago generate --input . --output output --add-changes%s
+`
+
+// InitRadar represents the scaffolding of a new radar repository.
+type InitRadar struct {
+	Dir          string
+	Title        string
+	Description  string
+	Quadrants    []core.Quadrant
+	Rings        []core.Ring
+	Date         string
+	WithTemplate bool
+	WithCI       bool
+}
+
+// Do executes the scaffolding.
+// It creates meta.yaml and a first dated snapshot with example entries in Dir.
+// If WithTemplate is true, a copy of the embedded template is added as template.html.
+// If WithCI is true, a GitHub Actions workflow running validate and generate is added.
+// Empty Title, Description, Quadrants and Rings fall back to the defaults from core.
+// Existing files are never overwritten. meta.yaml is written last and the files created
+// by this run are removed on failure, so a failed scaffolding can be rerun.
+func (i *InitRadar) Do() (err error) {
+	metaPath := filepath.Join(i.Dir, "meta.yaml")
+	if _, err := os.Stat(metaPath); err == nil {
+		return fmt.Errorf("radar already initialized: %s exists", metaPath)
+	}
+	if _, err := time.Parse(core.DefaultDateLayout, i.Date); err != nil {
+		return fmt.Errorf("invalid date '%s': expected YYYYMMDD", i.Date)
+	}
+
+	meta := core.NewMeta(i.Title, i.Description, i.Quadrants, i.Rings)
+	metaData, err := marshalYAML(core.MetaFile{
+		Title:       meta.Title,
+		Description: meta.Description,
+		Quadrants:   meta.Quadrants,
+		Rings:       meta.Rings,
+	})
+	if err != nil {
+		return err
+	}
+
+	techData, err := marshalYAML(technologiesOutput{Technologies: exampleTechnologies(meta)})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(i.Dir, 0755); err != nil {
+		return err
+	}
+
+	var created []string
+	defer func() {
+		if err != nil {
+			for _, path := range created {
+				os.Remove(path)
+			}
+		}
+	}()
+	write := func(path string, data []byte) error {
+		if err := writeNewFile(path, data, false); err != nil {
+			return err
+		}
+		created = append(created, path)
+		return nil
+	}
+
+	if err := write(filepath.Join(i.Dir, i.Date+".yaml"), techData); err != nil {
+		return err
+	}
+
+	templateFlag := ""
+	if i.WithTemplate {
+		if err := write(filepath.Join(i.Dir, "template.html"), []byte(radar.HTML)); err != nil {
+			return err
+		}
+		templateFlag = " --template template.html"
+	}
+
+	if i.WithCI {
+		workflowDir := filepath.Join(i.Dir, ".github", "workflows")
+		if err := os.MkdirAll(workflowDir, 0755); err != nil {
+			return err
+		}
+		workflow := fmt.Sprintf(ciWorkflow, templateFlag)
+		if err := write(filepath.Join(workflowDir, "radar.yml"), []byte(workflow)); err != nil {
+			return err
+		}
+	}
+
+	return write(metaPath, metaData)
+}
+
+// exampleTechnologies returns one example technology per quadrant,
+// spread over the rings of the meta.
+func exampleTechnologies(meta core.Meta) []core.Technology {
+	var technologies []core.Technology
+	if len(meta.Rings) == 0 {
+		return technologies
+	}
+	for i, q := range meta.Quadrants {
+		ring := meta.Rings[i%len(meta.Rings)]
+		technologies = append(technologies, core.Technology{
+			Name:        "Example " + q.Name,
+			Ring:        ring.Name,
+			Quadrant:    q.Name,
+			Description: "Replace with a real technology from the " + q.Name + " quadrant",
+		})
+	}
+	return technologies
+}
+
+// QuadrantsFromNames creates quadrants with aliases derived from the names.
+func QuadrantsFromNames(names []string) []core.Quadrant {
+	var quadrants []core.Quadrant
+	for _, name := range names {
+		quadrants = append(quadrants, core.Quadrant{Name: name, Alias: slugify(name)})
+	}
+	return quadrants
+}
+
+// RingsFromNames creates rings with aliases derived from the names.
+func RingsFromNames(names []string) []core.Ring {
+	var rings []core.Ring
+	for _, name := range names {
+		rings = append(rings, core.Ring{Name: name, Alias: slugify(name)})
+	}
+	return rings
+}
@@ -0,0 +1,155 @@
+package usecases
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+func TestInitRadar(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "radar")
+
+		initializer := InitRadar{Dir: dir, Date: "20240101"}
+		if err := initializer.Do(); err != nil {
+			t.Fatalf("InitRadar failed: %v", err)
+		}
+
+		meta, err := ReadMeta("", dir, false, false)
+		if err != nil {
+			t.Fatalf("ReadMeta failed: %v", err)
+		}
+		if len(meta.Quadrants) != len(core.DefaultQuadrants) {
+			t.Errorf("Expected %d quadrants, got %d", len(core.DefaultQuadrants), len(meta.Quadrants))
+		}
+		if len(meta.Rings) != len(core.DefaultRings) {
+			t.Errorf("Expected %d rings, got %d", len(core.DefaultRings), len(meta.Rings))
+		}
+
+		// Example snapshot should be valid
+		if err := ValidateTechnologiesFile(filepath.Join(dir, "20240101.yaml"), meta); err != nil {
+			t.Errorf("Example snapshot is not valid: %v", err)
+		}
+
+		// Optional files should not be created
+		if _, err := os.Stat(filepath.Join(dir, "template.html")); !os.IsNotExist(err) {
+			t.Error("template.html should not be created without WithTemplate")
+		}
+		if _, err := os.Stat(filepath.Join(dir, ".github", "workflows", "radar.yml")); !os.IsNotExist(err) {
+			t.Error("CI workflow should not be created without WithCI")
+		}
+
+		// Second run should fail
+		if err := initializer.Do(); err == nil {
+			t.Error("Expected error when radar is already initialized, got nil")
+		}
+	})
+
+	t.Run("custom meta with template and ci", func(t *testing.T) {
+		dir := t.TempDir()
+
+		initializer := InitRadar{
+			Dir:          dir,
+			Title:        "Platform Radar",
+			Quadrants:    QuadrantsFromNames([]string{"Tools", "Data Stores"}),
+			Rings:        RingsFromNames([]string{"Use", "Avoid"}),
+			Date:         "20240101",
+			WithTemplate: true,
+			WithCI:       true,
+		}
+		if err := initializer.Do(); err != nil {
+			t.Fatalf("InitRadar failed: %v", err)
+		}
+
+		meta, err := ReadMeta("", dir, false, false)
+		if err != nil {
+			t.Fatalf("ReadMeta failed: %v", err)
+		}
+		if meta.Title != "Platform Radar" {
+			t.Errorf("Expected title 'Platform Radar', got '%s'", meta.Title)
+		}
+		if !meta.IsValidQuadrant("data-stores") {
+			t.Error("Expected quadrant alias 'data-stores' to be valid")
+		}
+
+		if err := ValidateTechnologiesFile(filepath.Join(dir, "20240101.yaml"), meta); err != nil {
+			t.Errorf("Example snapshot is not valid: %v", err)
+		}
+
+		if _, err := os.Stat(filepath.Join(dir, "template.html")); err != nil {
+			t.Errorf("template.html should be created: %v", err)
+		}
+
+		workflow, err := os.ReadFile(filepath.Join(dir, ".github", "workflows", "radar.yml"))
+		if err != nil {
+			t.Fatalf("CI workflow should be created: %v", err)
+		}
+		if !strings.Contains(string(workflow), "terago validate") || !strings.Contains(string(workflow), "--template template.html") {
+			t.Errorf("Unexpected CI workflow content:\n%s", workflow)
+		}
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		dir := t.TempDir()
+
+		initializer := InitRadar{Dir: dir, Date: "2024-01-01"}
+		if err := initializer.Do(); err == nil {
+			t.Fatal("Expected error for invalid date, got nil")
+		}
+		if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+			t.Errorf("Expected no files for invalid date, got %d", len(entries))
+		}
+	})
+
+	t.Run("failure can be rerun", func(t *testing.T) {
+		dir := t.TempDir()
+		workflowDir := filepath.Join(dir, ".github", "workflows")
+		if err := os.MkdirAll(workflowDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		workflowPath := filepath.Join(workflowDir, "radar.yml")
+		if err := os.WriteFile(workflowPath, []byte("existing"), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		initializer := InitRadar{Dir: dir, Date: "20240101", WithCI: true}
+		err := initializer.Do()
+		if err == nil {
+			t.Fatal("Expected error for existing workflow, got nil")
+		}
+		if strings.Contains(err.Error(), "--force") {
+			t.Errorf("init has no --force flag, got %v", err)
+		}
+		for _, name := range []string{"meta.yaml", "20240101.yaml"} {
+			if _, err := os.Stat(filepath.Join(dir, name)); !os.IsNotExist(err) {
+				t.Errorf("%s should be removed after failure", name)
+			}
+		}
+
+		// Existing files are kept after a failure
+		templatePath := filepath.Join(dir, "template.html")
+		if err := os.WriteFile(templatePath, []byte("custom"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		withTemplate := InitRadar{Dir: dir, Date: "20240101", WithTemplate: true, WithCI: true}
+		if err := withTemplate.Do(); err == nil {
+			t.Fatal("Expected error for existing template, got nil")
+		}
+		if data, err := os.ReadFile(templatePath); err != nil || string(data) != "custom" {
+			t.Errorf("Existing template should be kept, got %q, %v", data, err)
+		}
+		if err := os.Remove(templatePath); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.Remove(workflowPath); err != nil {
+			t.Fatal(err)
+		}
+		if err := initializer.Do(); err != nil {
+			t.Errorf("Rerun after failure failed: %v", err)
+		}
+	})
+}
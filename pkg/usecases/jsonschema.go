@@ -0,0 +1,120 @@
+package usecases
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+// Kinds of files a JSON Schema can be generated for.
+const (
+	SchemaKindMeta         = "meta"
+	SchemaKindTechnologies = "technologies"
+)
+
+// jsonSchemaDraft is the JSON Schema version of generated schemas.
+const jsonSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+// JSONSchema returns a JSON Schema for meta or technologies files generated from the core types,
+// so the schema accepts exactly the keys the strict YAML decoding accepts.
+// When meta is given, technology rings and quadrants are restricted to its names and aliases.
+func JSONSchema(kind string, meta *core.Meta) (map[string]interface{}, error) {
+	var schema map[string]interface{}
+
+	switch kind {
+	case SchemaKindMeta:
+		schema = typeSchema(reflect.TypeOf(core.MetaFile{}))
+		schema["title"] = "terago meta file"
+
+		// Lint rules are known by name, severities are fixed
+		lint := property(schema, "lint")
+		rules := property(lint, "rules")
+		var names []string
+		for _, rule := range lintRules {
+			names = append(names, rule.Name())
+		}
+		sort.Strings(names)
+		rules["propertyNames"] = map[string]interface{}{"enum": names}
+		ruleSchema := rules["additionalProperties"].(map[string]interface{})
+		property(ruleSchema, "severity")["enum"] = []string{core.SeverityError, core.SeverityWarning, core.LintSeverityOff}
+	case SchemaKindTechnologies:
+		schema = typeSchema(reflect.TypeOf(core.TechnologiesFile{}))
+		schema["title"] = "terago technologies file"
+		schema["required"] = []string{"technologies"}
+
+		technology := property(schema, "technologies")["items"].(map[string]interface{})
+		technology["required"] = slices.Clone(core.RequiredTechnologyFields)
+		for _, field := range core.RequiredTechnologyFields {
+			property(technology, field)["minLength"] = 1
+		}
+		if meta != nil {
+			var rings, quadrants []string
+			for _, r := range meta.Rings {
+				rings = appendUnique(rings, r.Name, r.Alias)
+			}
+			for _, q := range meta.Quadrants {
+				quadrants = appendUnique(quadrants, q.Name, q.Alias)
+			}
+			property(technology, "ring")["enum"] = rings
+			property(technology, "quadrant")["enum"] = quadrants
+		}
+	default:
+		return nil, fmt.Errorf("unknown schema kind '%s' (use %s or %s)", kind, SchemaKindMeta, SchemaKindTechnologies)
+	}
+
+	schema["$schema"] = jsonSchemaDraft
+	return schema, nil
+}
+
+// typeSchema returns the JSON Schema of a Go type as decoded from YAML.
+// Structs don't allow keys other than their YAML fields.
+func typeSchema(t reflect.Type) map[string]interface{} {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		properties := make(map[string]interface{})
+		for name, fieldType := range yamlFields(t) {
+			properties[name] = typeSchema(fieldType)
+		}
+		return map[string]interface{}{
+			"type":                 "object",
+			"properties":           properties,
+			"additionalProperties": false,
+		}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": "array", "items": typeSchema(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": typeSchema(t.Elem())}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	default:
+		return map[string]interface{}{"type": "string"}
+	}
+}
+
+// property returns the schema of an object property.
+func property(schema map[string]interface{}, name string) map[string]interface{} {
+	return schema["properties"].(map[string]interface{})[name].(map[string]interface{})
+}
+
+// appendUnique appends non-empty values that are not in the list yet.
+func appendUnique(list []string, values ...string) []string {
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" && !slices.Contains(list, v) {
+			list = append(list, v)
+		}
+	}
+	return list
+}
@@ -0,0 +1,106 @@
+package usecases
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+func TestJSONSchemaTechnologies(t *testing.T) {
+	schema, err := JSONSchema(SchemaKindTechnologies, nil)
+	if err != nil {
+		t.Fatalf("JSONSchema failed: %v", err)
+	}
+	if _, err := json.Marshal(schema); err != nil {
+		t.Fatalf("Schema is not JSON serializable: %v", err)
+	}
+
+	// File-level properties are the keys of a technologies file, without the date from the file name
+	var fileKeys []string
+	for key := range schema["properties"].(map[string]interface{}) {
+		fileKeys = append(fileKeys, key)
+	}
+	sort.Strings(fileKeys)
+	if expected := []string{"draft", "publishedAt", "summary", "technologies", "title"}; !reflect.DeepEqual(fileKeys, expected) {
+		t.Errorf("Expected file properties %v, got %v", expected, fileKeys)
+	}
+	if schema["additionalProperties"] != false {
+		t.Error("Expected unknown file keys to be rejected")
+	}
+
+	technology := property(schema, "technologies")["items"].(map[string]interface{})
+	if technology["additionalProperties"] != false {
+		t.Error("Expected unknown technology keys to be rejected")
+	}
+	if !reflect.DeepEqual(technology["required"], core.RequiredTechnologyFields) {
+		t.Errorf("Expected required fields %v, got %v", core.RequiredTechnologyFields, technology["required"])
+	}
+
+	// Schema properties are exactly the keys accepted by strict decoding
+	var keys, expected []string
+	for key := range technology["properties"].(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+	for key := range yamlFields(reflect.TypeOf(core.Technology{})) {
+		expected = append(expected, key)
+	}
+	sort.Strings(keys)
+	sort.Strings(expected)
+	if !reflect.DeepEqual(keys, expected) {
+		t.Errorf("Expected properties %v, got %v", expected, keys)
+	}
+
+	if _, ok := property(technology, "ring")["enum"]; ok {
+		t.Error("Expected no ring enum without meta")
+	}
+	if property(technology, "tags")["type"] != "array" {
+		t.Errorf("Expected tags to be an array, got %v", property(technology, "tags"))
+	}
+}
+
+func TestJSONSchemaTechnologiesWithMeta(t *testing.T) {
+	meta := core.NewMeta("", "", []core.Quadrant{{Name: "Tools", Alias: "tools"}}, []core.Ring{{Name: "Use", Alias: "Use"}, {Name: "Avoid", Alias: "avoid"}})
+
+	schema, err := JSONSchema(SchemaKindTechnologies, &meta)
+	if err != nil {
+		t.Fatalf("JSONSchema failed: %v", err)
+	}
+
+	technology := property(schema, "technologies")["items"].(map[string]interface{})
+	if rings := property(technology, "ring")["enum"]; !reflect.DeepEqual(rings, []string{"Use", "Avoid", "avoid"}) {
+		t.Errorf("Unexpected ring enum: %v", rings)
+	}
+	if quadrants := property(technology, "quadrant")["enum"]; !reflect.DeepEqual(quadrants, []string{"Tools", "tools"}) {
+		t.Errorf("Unexpected quadrant enum: %v", quadrants)
+	}
+}
+
+func TestJSONSchemaMeta(t *testing.T) {
+	schema, err := JSONSchema(SchemaKindMeta, nil)
+	if err != nil {
+		t.Fatalf("JSONSchema failed: %v", err)
+	}
+
+	ring := property(schema, "rings")["items"].(map[string]interface{})
+	for _, key := range []string{"name", "alias", "persist", "maxAge"} {
+		if _, ok := ring["properties"].(map[string]interface{})[key]; !ok {
+			t.Errorf("Expected ring property %s", key)
+		}
+	}
+	if property(ring, "persist")["type"] != "boolean" {
+		t.Errorf("Expected persist to be boolean, got %v", property(ring, "persist"))
+	}
+
+	rules := property(property(schema, "lint"), "rules")
+	names := rules["propertyNames"].(map[string]interface{})["enum"].([]string)
+	if len(names) != len(lintRules) {
+		t.Errorf("Expected %d lint rule names, got %v", len(lintRules), names)
+	}
+
+	if _, err := JSONSchema("radar", nil); err == nil {
+		t.Error("Expected error for unknown kind, got nil")
+	}
+}
@@ -0,0 +1,342 @@
+package usecases
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+// LintConfigFile is the name of the lint configuration file in the input directory.
+// When present, it takes precedence over the lint section of meta.yaml.
+const LintConfigFile = ".terago-lint.yaml"
+
+// LintContext holds the data a lint rule checks: the snapshot at Index
+// with all previous snapshots available in Files[:Index].
+type LintContext struct {
+	Files  []core.TechnologiesFile
+	Index  int
+	Meta   core.Meta
+	Config core.LintRuleConfig
+}
+
+// Snapshot returns the checked snapshot.
+func (c LintContext) Snapshot() core.TechnologiesFile {
+	return c.Files[c.Index]
+}
+
+// LintRule is a policy check over radar content, enabled in the lint configuration.
+// Problems returned by Check need only Technology, Field, Message and position,
+// the rule ID, severity and (unless set) the file are filled in by the linter.
+type LintRule interface {
+	// Name returns the rule ID used in the lint configuration
+	Name() string
+	// Description returns a short description of the rule
+	Description() string
+	Check(ctx LintContext) core.ValidationErrors
+}
+
+var lintRules = []LintRule{
+	DescriptionLengthRule{},
+	NoDirectAdoptRule{},
+	RingJumpRule{},
+	HoldReplacedByRule{},
+	RequiredTagsRule{},
+}
+
+// RegisterLintRule adds a lint rule, replacing a registered rule with the same name.
+// The name becomes valid in the lint configuration of meta.
+func RegisterLintRule(rule LintRule) {
+	core.RegisterLintRuleName(rule.Name())
+	for i, r := range lintRules {
+		if r.Name() == rule.Name() {
+			lintRules[i] = rule
+			return
+		}
+	}
+	lintRules = append(lintRules, rule)
+}
+
+// LintRules returns all registered lint rules.
+func LintRules() []LintRule {
+	return slices.Clone(lintRules)
+}
+
+// ReadLintConfig returns the lint configuration from .terago-lint.yaml in the input directory,
+// or from the lint section of meta if there is no such file.
+// Unknown rules and unknown keys in the file are rejected, unknown keys are allowed if lenient is set.
+func ReadLintConfig(inputDir string, meta core.Meta, lenient bool) (core.LintConfig, error) {
+	filePath := filepath.Join(inputDir, LintConfigFile)
+	data, err := os.ReadFile(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return meta.Lint, nil
+	}
+	if err != nil {
+		return core.LintConfig{}, fmt.Errorf("error reading lint config: %v", err)
+	}
+
+	var config core.LintConfig
+	var doc yaml.Node
+	err = yaml.Unmarshal(data, &doc)
+	if err == nil && doc.Kind != 0 {
+		err = doc.Decode(&config)
+	}
+	if err != nil {
+		return core.LintConfig{}, fmt.Errorf("error parsing lint config %s: %v", filePath, err)
+	}
+
+	// Reject typos in rule names and keys instead of silently ignoring them
+	var names []string
+	for _, rule := range lintRules {
+		names = append(names, rule.Name())
+	}
+	rules := mappingValue(documentRoot(&doc), "rules")
+	for i := 0; rules != nil && i+1 < len(rules.Content); i += 2 {
+		key := unknownKey{Node: rules.Content[i], Known: names}
+		if slices.Contains(names, key.Node.Value) {
+			continue
+		}
+		message := fmt.Sprintf("unknown lint rule '%s'", key.Node.Value)
+		if suggestion := key.Suggestion(); suggestion != "" {
+			message += fmt.Sprintf(" (did you mean '%s'?)", suggestion)
+		}
+		return core.LintConfig{}, fmt.Errorf("%s:%d:%d: %s", filePath, key.Node.Line, key.Node.Column, message)
+	}
+	unknown, err := unknownFields(&doc, reflect.TypeOf(config))
+	if err != nil {
+		return core.LintConfig{}, fmt.Errorf("failed to parse lint config '%s': %v", filePath, err)
+	}
+	if len(unknown) > 0 && !lenient {
+		key := unknown[0]
+		return core.LintConfig{}, fmt.Errorf("%s:%d:%d: %s", filePath, key.Node.Line, key.Node.Column, key.Message("lint config"))
+	}
+	if problems := config.Validate(); len(problems) > 0 {
+		return core.LintConfig{}, fmt.Errorf("invalid lint config %s: %s", filePath, strings.Join(problems, "; "))
+	}
+	return config, nil
+}
+
+// LintSnapshot runs the enabled lint rules against the latest snapshot. Files must be
+// sorted by date (as returned by ReadTechnologiesFiles) and filePath is the path of the latest one.
+func LintSnapshot(files []core.TechnologiesFile, filePath string, meta core.Meta, config core.LintConfig) (core.ValidationErrors, error) {
+	var errs core.ValidationErrors
+
+	for name := range config.Rules {
+		if !slices.ContainsFunc(lintRules, func(r LintRule) bool { return r.Name() == name }) {
+			return nil, fmt.Errorf("unknown lint rule '%s'", name)
+		}
+	}
+	if len(files) == 0 {
+		return errs, nil
+	}
+
+	for _, rule := range lintRules {
+		severity := config.RuleSeverity(rule.Name())
+		switch severity {
+		case core.LintSeverityOff:
+			continue
+		case core.SeverityError, core.SeverityWarning:
+		default:
+			return nil, fmt.Errorf("invalid severity '%s' of lint rule '%s' (use error, warning or off)", severity, rule.Name())
+		}
+
+		ctx := LintContext{
+			Files:  files,
+			Index:  len(files) - 1,
+			Meta:   meta,
+			Config: config.Rules[rule.Name()],
+		}
+		for _, e := range rule.Check(ctx) {
+			e.File = cmp.Or(e.File, filePath)
+			e.Rule = rule.Name()
+			e.Severity = severity
+			errs = append(errs, e)
+		}
+	}
+
+	return errs, nil
+}
+
+// lintError returns a problem found in a technology field.
+func lintError(tech core.Technology, field, format string, args ...interface{}) core.ValidationError {
+	pos := tech.FieldPosition(field)
+	return core.ValidationError{
+		File:       pos.File,
+		Technology: tech.Name,
+		Field:      field,
+		Message:    fmt.Sprintf(format, args...),
+		Line:       pos.Line,
+		Column:     pos.Column,
+	}
+}
+
+// lintRings returns the rings configured for a rule, or the default ring.
+func lintRings(config core.LintRuleConfig, defaultRing string) []string {
+	if len(config.Rings) > 0 {
+		return config.Rings
+	}
+	return []string{defaultRing}
+}
+
+// inRings checks if the ring (by name or alias) is one of the given rings.
+func inRings(meta core.Meta, ring string, rings []string) bool {
+	r, ok := meta.FindRing(ring)
+	if !ok {
+		return false
+	}
+	return slices.Contains(rings, r.Name) || slices.Contains(rings, r.Alias)
+}
+
+// DescriptionLengthRule requires descriptions to be between min and max characters long.
+type DescriptionLengthRule struct{}
+
+func (DescriptionLengthRule) Name() string { return core.LintDescriptionLength }
+
+func (DescriptionLengthRule) Description() string {
+	return "Technology description is shorter than min or longer than max characters"
+}
+
+func (DescriptionLengthRule) Check(ctx LintContext) core.ValidationErrors {
+	var errs core.ValidationErrors
+	for _, tech := range ctx.Snapshot().Technologies {
+		if tech.IsDeleted {
+			continue
+		}
+		length := utf8.RuneCountInString(tech.Description)
+		if ctx.Config.Min > 0 && length < ctx.Config.Min {
+			errs = append(errs, lintError(tech, "description",
+				"description of technology '%s' is too short (%d < %d characters)", tech.Name, length, ctx.Config.Min))
+		}
+		if ctx.Config.Max > 0 && length > ctx.Config.Max {
+			errs = append(errs, lintError(tech, "description",
+				"description of technology '%s' is too long (%d > %d characters)", tech.Name, length, ctx.Config.Max))
+		}
+	}
+	return errs
+}
+
+// NoDirectAdoptRule forbids new technologies entering directly at the innermost ring
+// (or at any of the configured rings). The first snapshot is not checked.
+type NoDirectAdoptRule struct{}
+
+func (NoDirectAdoptRule) Name() string { return core.LintNoDirectAdopt }
+
+func (NoDirectAdoptRule) Description() string {
+	return "New technology enters the radar directly at Adopt"
+}
+
+func (NoDirectAdoptRule) Check(ctx LintContext) core.ValidationErrors {
+	var errs core.ValidationErrors
+	if ctx.Index == 0 || len(ctx.Meta.Rings) == 0 {
+		return errs
+	}
+
+	rings := lintRings(ctx.Config, ctx.Meta.Rings[0].Name)
+	for _, tech := range ctx.Snapshot().Technologies {
+		if !tech.IsNew || !inRings(ctx.Meta, tech.Ring, rings) {
+			continue
+		}
+		errs = append(errs, lintError(tech, "ring",
+			"new technology '%s' enters directly at ring '%s'", tech.Name, tech.Ring))
+	}
+	return errs
+}
+
+// RingJumpRule forbids moving a technology more than maxSteps rings (default 1) in one period.
+type RingJumpRule struct{}
+
+func (RingJumpRule) Name() string { return core.LintRingJump }
+
+func (RingJumpRule) Description() string {
+	return "Technology moved more than the allowed number of rings in one period"
+}
+
+func (RingJumpRule) Check(ctx LintContext) core.ValidationErrors {
+	var errs core.ValidationErrors
+	maxSteps := ctx.Config.MaxSteps
+	if maxSteps <= 0 {
+		maxSteps = 1
+	}
+
+	for _, tech := range ctx.Snapshot().Technologies {
+		if !tech.IsMoved {
+			continue
+		}
+		steps := getRingIndex(tech.Ring, ctx.Meta.Rings) - getRingIndex(tech.PreviousRing, ctx.Meta.Rings)
+		if steps < 0 {
+			steps = -steps
+		}
+		if steps > maxSteps {
+			errs = append(errs, lintError(tech, "ring",
+				"technology '%s' moved from '%s' to '%s' (%d rings, at most %d allowed)",
+				tech.Name, tech.PreviousRing, tech.Ring, steps, maxSteps))
+		}
+	}
+	return errs
+}
+
+// HoldReplacedByRule requires technologies in the outermost ring (or in any of
+// the configured rings) to name a replacement in replacedBy.
+type HoldReplacedByRule struct{}
+
+func (HoldReplacedByRule) Name() string { return core.LintHoldReplacedBy }
+
+func (HoldReplacedByRule) Description() string {
+	return "Technology in Hold has no replacedBy"
+}
+
+func (HoldReplacedByRule) Check(ctx LintContext) core.ValidationErrors {
+	var errs core.ValidationErrors
+	if len(ctx.Meta.Rings) == 0 {
+		return errs
+	}
+
+	rings := lintRings(ctx.Config, ctx.Meta.Rings[len(ctx.Meta.Rings)-1].Name)
+	for _, tech := range ctx.Snapshot().Technologies {
+		if tech.IsDeleted || tech.ReplacedBy != "" || !inRings(ctx.Meta, tech.Ring, rings) {
+			continue
+		}
+		errs = append(errs, lintError(tech, "ring",
+			"technology '%s' in ring '%s' has no 'replacedBy'", tech.Name, tech.Ring))
+	}
+	return errs
+}
+
+// RequiredTagsRule requires every technology to have at least one tag,
+// or at least one of the configured tags.
+type RequiredTagsRule struct{}
+
+func (RequiredTagsRule) Name() string { return core.LintRequiredTags }
+
+func (RequiredTagsRule) Description() string {
+	return "Technology has none of the required tags"
+}
+
+func (RequiredTagsRule) Check(ctx LintContext) core.ValidationErrors {
+	var errs core.ValidationErrors
+	for _, tech := range ctx.Snapshot().Technologies {
+		if tech.IsDeleted {
+			continue
+		}
+		if len(ctx.Config.Tags) == 0 {
+			if len(tech.Tags) == 0 {
+				errs = append(errs, lintError(tech, "name", "technology '%s' has no tags", tech.Name))
+			}
+			continue
+		}
+		if !slices.ContainsFunc(tech.Tags, func(tag string) bool { return slices.Contains(ctx.Config.Tags, tag) }) {
+			errs = append(errs, lintError(tech, "name",
+				"technology '%s' has none of the required tags %v", tech.Name, ctx.Config.Tags))
+		}
+	}
+	return errs
+}
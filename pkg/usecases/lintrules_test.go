@@ -0,0 +1,222 @@
+package usecases
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+// lintFiles returns two snapshots with changes marked as ReadTechnologiesFiles does
+func lintFiles(previous, current []core.Technology) []core.TechnologiesFile {
+	files := []core.TechnologiesFile{
+		{Date: "20240101", Technologies: previous},
+		{Date: "20240201", Technologies: current},
+	}
+	markChanges(&files[1].Technologies, files[0].Technologies)
+	return files
+}
+
+func TestLintRules(t *testing.T) {
+	meta := core.DefaultMeta()
+	previous := []core.Technology{
+		{Name: "Go", Ring: "Trial", Quadrant: "Languages", Description: "Programming language", Tags: []string{"backend"}},
+		{Name: "Kafka", Ring: "Hold", Quadrant: "Platforms", Description: "Event streaming", ReplacedBy: "NATS"},
+	}
+	current := []core.Technology{
+		{Name: "Go", Ring: "Adopt", Quadrant: "Languages", Description: "Programming language", Tags: []string{"backend"}},
+		{Name: "Kafka", Ring: "Adopt", Quadrant: "Platforms", Description: "Event streaming", Tags: []string{"data"}},
+		{Name: "Rust", Ring: "Adopt", Quadrant: "Languages", Description: "Lang"},
+		{Name: "Perl", Ring: "Hold", Quadrant: "Languages", Description: "Scripting language", Tags: []string{"legacy"}},
+	}
+	files := lintFiles(previous, current)
+
+	tests := []struct {
+		rule   LintRule
+		config core.LintRuleConfig
+		want   []string
+	}{
+		{DescriptionLengthRule{}, core.LintRuleConfig{Min: 5, Max: 18},
+			[]string{"description of technology 'Go' is too long (20 > 18 characters)", "description of technology 'Rust' is too short (4 < 5 characters)"}},
+		{NoDirectAdoptRule{}, core.LintRuleConfig{}, []string{"new technology 'Rust' enters directly at ring 'Adopt'"}},
+		{NoDirectAdoptRule{}, core.LintRuleConfig{Rings: []string{"Hold"}}, []string{"new technology 'Perl' enters directly at ring 'Hold'"}},
+		{RingJumpRule{}, core.LintRuleConfig{}, []string{"technology 'Kafka' moved from 'Hold' to 'Adopt' (3 rings, at most 1 allowed)"}},
+		{RingJumpRule{}, core.LintRuleConfig{MaxSteps: 3}, nil},
+		{HoldReplacedByRule{}, core.LintRuleConfig{}, []string{"technology 'Perl' in ring 'Hold' has no 'replacedBy'"}},
+		{RequiredTagsRule{}, core.LintRuleConfig{}, []string{"technology 'Rust' has no tags"}},
+		{RequiredTagsRule{}, core.LintRuleConfig{Tags: []string{"backend", "data"}},
+			[]string{"technology 'Rust' has none of the required tags [backend data]", "technology 'Perl' has none of the required tags [backend data]"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule.Name(), func(t *testing.T) {
+			errs := tt.rule.Check(LintContext{Files: files, Index: 1, Meta: meta, Config: tt.config})
+			if len(errs) != len(tt.want) {
+				t.Fatalf("Expected %d problems, got %d: %v", len(tt.want), len(errs), errs)
+			}
+			for i, want := range tt.want {
+				if errs[i].Message != want {
+					t.Errorf("Expected %q, got %q", want, errs[i].Message)
+				}
+			}
+		})
+	}
+
+	// The first snapshot has no history, so nothing enters "directly"
+	errs := NoDirectAdoptRule{}.Check(LintContext{Files: files, Index: 0, Meta: meta})
+	if len(errs) != 0 {
+		t.Errorf("Expected no problems in the first snapshot, got %v", errs)
+	}
+}
+
+func TestLintSnapshot(t *testing.T) {
+	meta := core.DefaultMeta()
+	files := lintFiles(
+		[]core.Technology{{Name: "Go", Ring: "Hold", Quadrant: "Languages", Description: "Programming language", ReplacedBy: "Rust"}},
+		[]core.Technology{{Name: "Go", Ring: "Adopt", Quadrant: "Languages", Description: "Go"}},
+	)
+
+	config := core.LintConfig{Rules: map[string]core.LintRuleConfig{
+		"ring-jump":          {Severity: core.SeverityError},
+		"description-length": {Min: 10},
+		"required-tags":      {Severity: core.LintSeverityOff},
+	}}
+	errs, err := LintSnapshot(files, "data/20240201.yaml", meta, config)
+	if err != nil {
+		t.Fatalf("LintSnapshot failed: %v", err)
+	}
+	if len(errs) != 2 {
+		t.Fatalf("Expected 2 problems, got %v", errs)
+	}
+	if errs[0].Rule != "description-length" || errs[0].Severity != core.SeverityWarning || errs[0].File != "data/20240201.yaml" {
+		t.Errorf("Unexpected problem: %+v", errs[0])
+	}
+	if errs[1].Rule != "ring-jump" || errs[1].Severity != core.SeverityError {
+		t.Errorf("Unexpected problem: %+v", errs[1])
+	}
+
+	_, err = LintSnapshot(files, "", meta, core.LintConfig{Rules: map[string]core.LintRuleConfig{"no-such-rule": {}}})
+	if err == nil || !strings.Contains(err.Error(), "unknown lint rule 'no-such-rule'") {
+		t.Errorf("Expected unknown rule error, got %v", err)
+	}
+	_, err = LintSnapshot(files, "", meta, core.LintConfig{Rules: map[string]core.LintRuleConfig{"ring-jump": {Severity: "fatal"}}})
+	if err == nil || !strings.Contains(err.Error(), "invalid severity 'fatal'") {
+		t.Errorf("Expected invalid severity error, got %v", err)
+	}
+}
+
+// testLintRule is a custom rule used to check rule registration
+type testLintRule struct{}
+
+func (testLintRule) Name() string        { return "test-rule" }
+func (testLintRule) Description() string { return "Test rule" }
+func (testLintRule) Check(ctx LintContext) core.ValidationErrors {
+	return core.ValidationErrors{{Message: "checked " + ctx.Snapshot().Date}}
+}
+
+func TestRegisterLintRule(t *testing.T) {
+	saved := lintRules
+	defer func() { lintRules = saved }()
+
+	RegisterLintRule(testLintRule{})
+	if n := len(LintRules()); n != len(saved)+1 {
+		t.Fatalf("Expected %d rules, got %d", len(saved)+1, n)
+	}
+
+	files := lintFiles(nil, []core.Technology{{Name: "Go"}})
+	config := core.LintConfig{Rules: map[string]core.LintRuleConfig{"test-rule": {}}}
+	errs, err := LintSnapshot(files, "f.yaml", core.DefaultMeta(), config)
+	if err != nil {
+		t.Fatalf("LintSnapshot failed: %v", err)
+	}
+	if len(errs) != 1 || errs[0].Message != "checked 20240201" || errs[0].Rule != "test-rule" {
+		t.Errorf("Unexpected problems: %+v", errs)
+	}
+}
+
+func TestReadLintConfig(t *testing.T) {
+	dir := t.TempDir()
+	meta := core.DefaultMeta()
+	meta.Lint = core.LintConfig{Rules: map[string]core.LintRuleConfig{"ring-jump": {}}}
+
+	config, err := ReadLintConfig(dir, meta, false)
+	if err != nil {
+		t.Fatalf("ReadLintConfig failed: %v", err)
+	}
+	if _, ok := config.Rules["ring-jump"]; !ok || len(config.Rules) != 1 {
+		t.Errorf("Expected lint config from meta, got %+v", config)
+	}
+
+	content := `rules:
+  description-length:
+    severity: error
+    min: 20
+`
+	if err := os.WriteFile(filepath.Join(dir, LintConfigFile), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write lint config: %v", err)
+	}
+	config, err = ReadLintConfig(dir, meta, false)
+	if err != nil {
+		t.Fatalf("ReadLintConfig failed: %v", err)
+	}
+	rule, ok := config.Rules["description-length"]
+	if !ok || len(config.Rules) != 1 || rule.Min != 20 || config.RuleSeverity("description-length") != core.SeverityError {
+		t.Errorf("Expected lint config from %s, got %+v", LintConfigFile, config)
+	}
+}
+
+func TestReadLintConfigUnknownKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		lenient bool
+		want    string
+	}{
+		{
+			name:    "unknown rule",
+			content: "rules:\n  descripton-length:\n    min: 20\n",
+			want:    ":2:3: unknown lint rule 'descripton-length' (did you mean 'description-length'?)",
+		},
+		{
+			name:    "unknown rule option",
+			content: "rules:\n  ring-jump:\n    severty: error\n",
+			want:    ":3:5: unknown field 'severty' in lint config (did you mean 'severity'?)",
+		},
+		{
+			name:    "unknown top-level key",
+			content: "rule:\n  ring-jump: {}\n",
+			want:    ":1:1: unknown field 'rule' in lint config (did you mean 'rules'?)",
+		},
+		{
+			name:    "invalid severity",
+			content: "rules:\n  ring-jump:\n    severity: fatal\n",
+			want:    ": invalid severity 'fatal' of lint rule 'ring-jump' (use error, warning or off)",
+		},
+		{
+			name:    "lenient",
+			content: "rules:\n  ring-jump:\n    severty: error\n",
+			lenient: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, LintConfigFile), []byte(tt.content), 0644); err != nil {
+				t.Fatalf("Failed to write lint config: %v", err)
+			}
+			_, err := ReadLintConfig(dir, core.DefaultMeta(), tt.lenient)
+			if tt.want == "" {
+				if err != nil {
+					t.Errorf("ReadLintConfig failed: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
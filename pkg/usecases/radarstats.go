@@ -0,0 +1,163 @@
+package usecases
+
+import (
+	"sort"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+// Count represents the number of technologies in a ring or quadrant.
+type Count struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// SnapshotStats represents technology counts and churn of a single snapshot.
+type SnapshotStats struct {
+	Date       string  `json:"date"`
+	Total      int     `json:"total"`
+	ByRing     []Count `json:"byRing"`
+	ByQuadrant []Count `json:"byQuadrant"`
+	New        int     `json:"new"`
+	Moved      int     `json:"moved"`
+	Deleted    int     `json:"deleted"`
+}
+
+// PromotionStats represents the average time spent in a ring before moving to an inner ring.
+type PromotionStats struct {
+	Ring           string  `json:"ring"`
+	Promotions     int     `json:"promotions"`
+	AveragePeriods float64 `json:"averagePeriods"`
+	AverageDays    float64 `json:"averageDays,omitempty"`
+}
+
+// StaleEntry represents a technology that hasn't changed ring for a number of periods.
+type StaleEntry struct {
+	Name     string `json:"name"`
+	Ring     string `json:"ring"`
+	Quadrant string `json:"quadrant"`
+	Since    string `json:"since"`
+	Periods  int    `json:"periods"`
+}
+
+// RadarStats represents health metrics of a radar.
+type RadarStats struct {
+	Snapshots  []SnapshotStats  `json:"snapshots"`
+	Promotions []PromotionStats `json:"promotions"`
+	Stale      []StaleEntry     `json:"stale"`
+}
+
+// GetRadarStats computes health metrics from snapshots sorted by date
+// (as returned by ReadTechnologiesFiles).
+// Technologies of the latest snapshot that stayed in the same ring for at least
+// staleAfter periods are reported as stale. Technology names are matched case-insensitively.
+// Rings and quadrants are reported in meta order.
+func GetRadarStats(files []core.TechnologiesFile, meta core.Meta, staleAfter int) RadarStats {
+	var stats RadarStats
+
+	for _, file := range files {
+		stats.Snapshots = append(stats.Snapshots, snapshotStats(file, meta))
+	}
+
+	type promotionSum struct {
+		count   int
+		periods int
+		days    int
+		dated   int
+	}
+	promotions := make(map[int]*promotionSum)
+
+	for _, history := range technologyHistories(files) {
+		for i := 0; i+1 < len(history.Rings); i++ {
+			from, to := history.Rings[i], history.Rings[i+1]
+			fromIndex := getRingIndex(from.Ring, meta.Rings)
+			if from.To != to.From || getRingIndex(to.Ring, meta.Rings) >= fromIndex {
+				continue // deleted in between or not moved to an inner ring
+			}
+			sum, ok := promotions[fromIndex]
+			if !ok {
+				sum = &promotionSum{}
+				promotions[fromIndex] = sum
+			}
+			sum.count++
+			sum.periods += from.Periods
+			if from.Days > 0 {
+				sum.days += from.Days
+				sum.dated++
+			}
+		}
+
+		if n := len(history.Rings); n > 0 && staleAfter > 0 {
+			last := history.Rings[n-1]
+			if last.Current && last.Periods >= staleAfter {
+				entry := history.Entries[len(history.Entries)-1]
+				stats.Stale = append(stats.Stale, StaleEntry{
+					Name:     history.Name,
+					Ring:     last.Ring,
+					Quadrant: entry.Quadrant,
+					Since:    last.From,
+					Periods:  last.Periods,
+				})
+			}
+		}
+	}
+
+	for i, ring := range meta.Rings {
+		sum, ok := promotions[i]
+		if !ok {
+			continue
+		}
+		p := PromotionStats{
+			Ring:           ring.Name,
+			Promotions:     sum.count,
+			AveragePeriods: float64(sum.periods) / float64(sum.count),
+		}
+		if sum.dated > 0 {
+			p.AverageDays = float64(sum.days) / float64(sum.dated)
+		}
+		stats.Promotions = append(stats.Promotions, p)
+	}
+
+	sort.SliceStable(stats.Stale, func(i, j int) bool {
+		return stats.Stale[i].Periods > stats.Stale[j].Periods
+	})
+
+	return stats
+}
+
+// snapshotStats counts technologies by ring and quadrant and the changes of a snapshot.
+func snapshotStats(file core.TechnologiesFile, meta core.Meta) SnapshotStats {
+	s := SnapshotStats{Date: file.Date}
+
+	ringCounts := make([]int, len(meta.Rings))
+	quadrantCounts := make([]int, len(meta.Quadrants))
+
+	for _, tech := range file.Technologies {
+		if tech.IsDeleted {
+			s.Deleted++
+			continue
+		}
+		s.Total++
+		if tech.IsNew {
+			s.New++
+		}
+		if tech.IsMoved {
+			s.Moved++
+		}
+		if len(ringCounts) > 0 {
+			ringCounts[getRingIndex(tech.Ring, meta.Rings)]++
+		}
+		if len(quadrantCounts) > 0 {
+			quadrantCounts[getQuadrantIndex(tech.Quadrant, meta.Quadrants)]++
+		}
+	}
+
+	for i, r := range meta.Rings {
+		s.ByRing = append(s.ByRing, Count{Name: r.Name, Count: ringCounts[i]})
+	}
+	for i, q := range meta.Quadrants {
+		s.ByQuadrant = append(s.ByQuadrant, Count{Name: q.Name, Count: quadrantCounts[i]})
+	}
+
+	return s
+}
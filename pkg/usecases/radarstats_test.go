@@ -0,0 +1,84 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+func TestGetRadarStats(t *testing.T) {
+	meta := core.DefaultMeta()
+
+	files := []core.TechnologiesFile{
+		{Date: "20240101", Technologies: []core.Technology{
+			{Name: "Go", Ring: "Adopt", Quadrant: "Languages", IsNew: true},
+			{Name: "Kafka", Ring: "Assess", Quadrant: "Platforms", IsNew: true},
+			{Name: "Rust", Ring: "Assess", Quadrant: "Languages", IsNew: true},
+		}},
+		{Date: "20240401", Technologies: []core.Technology{
+			{Name: "Go", Ring: "Adopt", Quadrant: "Languages"},
+			{Name: "Kafka", Ring: "Trial", Quadrant: "Platforms", IsMoved: true, PreviousRing: "Assess"},
+			{Name: "Rust", Ring: "Assess", Quadrant: "Languages"},
+		}},
+		{Date: "20240701", Technologies: []core.Technology{
+			// Names are matched case-insensitively
+			{Name: "go", Ring: "Adopt", Quadrant: "Languages"},
+			{Name: "Kafka", Ring: "Adopt", Quadrant: "Platforms", IsMoved: true, PreviousRing: "Trial"},
+			{Name: "Svelte", Ring: "assess", Quadrant: "frameworks", IsNew: true},
+			{Name: "Rust", Ring: "Assess", Quadrant: "Languages", IsDeleted: true},
+		}},
+	}
+
+	stats := GetRadarStats(files, meta, 3)
+
+	if len(stats.Snapshots) != 3 {
+		t.Fatalf("Expected 3 snapshots, got %d", len(stats.Snapshots))
+	}
+
+	last := stats.Snapshots[2]
+	if last.Total != 3 || last.New != 1 || last.Moved != 1 || last.Deleted != 1 {
+		t.Errorf("Unexpected churn for last snapshot: %+v", last)
+	}
+
+	expectedRings := []Count{{"Adopt", 2}, {"Trial", 0}, {"Assess", 1}, {"Hold", 0}}
+	for i, c := range last.ByRing {
+		if c != expectedRings[i] {
+			t.Errorf("ByRing[%d]: expected %+v, got %+v", i, expectedRings[i], c)
+		}
+	}
+
+	expectedQuadrants := []Count{{"Languages", 1}, {"Frameworks", 1}, {"Platforms", 1}, {"Techniques", 0}}
+	for i, c := range last.ByQuadrant {
+		if c != expectedQuadrants[i] {
+			t.Errorf("ByQuadrant[%d]: expected %+v, got %+v", i, expectedQuadrants[i], c)
+		}
+	}
+
+	// Kafka: Assess for 1 period (91 days), then Trial for 1 period (91 days)
+	expectedPromotions := []PromotionStats{
+		{Ring: "Trial", Promotions: 1, AveragePeriods: 1, AverageDays: 91},
+		{Ring: "Assess", Promotions: 1, AveragePeriods: 1, AverageDays: 91},
+	}
+	if len(stats.Promotions) != len(expectedPromotions) {
+		t.Fatalf("Expected %d promotion stats, got %+v", len(expectedPromotions), stats.Promotions)
+	}
+	for i, p := range stats.Promotions {
+		if p != expectedPromotions[i] {
+			t.Errorf("Promotions[%d]: expected %+v, got %+v", i, expectedPromotions[i], p)
+		}
+	}
+
+	// Only Go stayed in the same ring for 3 periods; Rust was deleted
+	if len(stats.Stale) != 1 {
+		t.Fatalf("Expected 1 stale entry, got %+v", stats.Stale)
+	}
+	expectedStale := StaleEntry{Name: "go", Ring: "Adopt", Quadrant: "Languages", Since: "20240101", Periods: 3}
+	if stats.Stale[0] != expectedStale {
+		t.Errorf("Expected stale entry %+v, got %+v", expectedStale, stats.Stale[0])
+	}
+
+	// Stale detection can be disabled
+	if stats := GetRadarStats(files, meta, 0); len(stats.Stale) != 0 {
+		t.Errorf("Expected no stale entries when disabled, got %+v", stats.Stale)
+	}
+}
@@ -0,0 +1,214 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+// gitRevision is a version of the technologies file in the git history.
+type gitRevision struct {
+	// Name of the revision in messages: short commit hash or tag name
+	Name string
+	Date time.Time
+	File *object.File
+}
+
+// ReadTechnologiesFromGit reads the versions of a single technologies file from the history
+// of the git repository it belongs to, reading the object database directly.
+// Each commit that changed the file (or each tag, if tags is true, at which the file changed)
+// becomes a snapshot dated by the commit or tag date. When the file changed several times
+// a day, the last version of the day is used. Versions with draft: true are skipped unless
+// opts.IncludeDrafts is set. Files are sorted by date with changes marked,
+// like the result of ReadTechnologiesFiles.
+// Only the latest version must be valid: older versions that fail to parse or validate
+// (e.g. against a newer meta or schema) can't be fixed, so they are skipped and returned
+// as warnings.
+func ReadTechnologiesFromGit(filePath string, meta core.Meta, tags bool, opts ReadOptions) ([]core.TechnologiesFile, core.ValidationErrors, error) {
+	var technologiesFiles []core.TechnologiesFile
+	var skipped core.ValidationErrors
+
+	absPath, err := filepath.Abs(filePath)
+	if err != nil {
+		return technologiesFiles, skipped, err
+	}
+	repo, err := git.PlainOpenWithOptions(filepath.Dir(absPath), &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return technologiesFiles, skipped, fmt.Errorf("error opening git repository for %s: %v", filePath, err)
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return technologiesFiles, skipped, fmt.Errorf("error opening git worktree: %v", err)
+	}
+	relPath, err := filepath.Rel(worktree.Filesystem.Root(), absPath)
+	if err != nil {
+		return technologiesFiles, skipped, err
+	}
+	relPath = filepath.ToSlash(relPath)
+
+	var revisions []gitRevision
+	if tags {
+		revisions, err = gitTagRevisions(repo, relPath)
+	} else {
+		revisions, err = gitCommitRevisions(repo, relPath)
+	}
+	if err != nil {
+		return technologiesFiles, skipped, err
+	}
+	if len(revisions) == 0 {
+		return technologiesFiles, skipped, fmt.Errorf("no revisions of %s found in git history", relPath)
+	}
+
+	// Keep the last revision of each day, skip revisions without changes
+	sort.SliceStable(revisions, func(i, j int) bool {
+		return revisions[i].Date.Before(revisions[j].Date)
+	})
+	latest := revisions[len(revisions)-1].File.Hash
+	var previous plumbing.Hash
+	for _, rev := range revisions {
+		if rev.File.Hash == previous {
+			continue
+		}
+		previous = rev.File.Hash
+
+		technologiesFile, err := readGitRevision(relPath, rev, meta, opts.Lenient)
+		if err != nil && rev.File.Hash == latest {
+			return technologiesFiles, skipped, err
+		}
+		if err != nil {
+			skipped = append(skipped, core.ValidationError{
+				File:     relPath,
+				Rule:     core.RuleInvalidRevision,
+				Severity: core.SeverityWarning,
+				Message:  fmt.Sprintf("skipped invalid revision: %v", err),
+			})
+			continue
+		}
+		if technologiesFile.Draft && !opts.IncludeDrafts {
+			continue
+		}
+
+		last := len(technologiesFiles) - 1
+		if last >= 0 && technologiesFiles[last].Date == technologiesFile.Date {
+			technologiesFiles[last] = technologiesFile
+			continue
+		}
+		technologiesFiles = append(technologiesFiles, technologiesFile)
+	}
+
+	markAllChanges(technologiesFiles)
+
+	return technologiesFiles, skipped, nil
+}
+
+// readGitRevision parses and validates the technologies file at a revision.
+func readGitRevision(relPath string, rev gitRevision, meta core.Meta, lenient bool) (core.TechnologiesFile, error) {
+	reader, err := rev.File.Reader()
+	if err != nil {
+		return core.TechnologiesFile{}, fmt.Errorf("error reading %s at %s: %v", relPath, rev.Name, err)
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return core.TechnologiesFile{}, fmt.Errorf("error reading %s at %s: %v", relPath, rev.Name, err)
+	}
+
+	technologiesFile, schemaErrs, err := parseTechnologies(relPath, data)
+	if err == nil {
+		err = checkTechnologiesFile(relPath, technologiesFile, schemaErrs, meta, lenient)
+	}
+	if err != nil {
+		return technologiesFile, fmt.Errorf("error processing %s at %s: %v", relPath, rev.Name, err)
+	}
+
+	year, month, day := rev.Date.Date()
+	technologiesFile.Date = rev.Date.Format(core.DefaultDateLayout)
+	technologiesFile.Time = time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return technologiesFile, nil
+}
+
+// gitCommitRevisions returns the versions of the file from the commits that changed it,
+// starting from HEAD.
+func gitCommitRevisions(repo *git.Repository, relPath string) ([]gitRevision, error) {
+	var revisions []gitRevision
+
+	commits, err := repo.Log(&git.LogOptions{FileName: &relPath})
+	if err != nil {
+		return revisions, fmt.Errorf("error reading git log: %v", err)
+	}
+	err = commits.ForEach(func(c *object.Commit) error {
+		file, err := c.File(relPath)
+		if errors.Is(err, object.ErrFileNotFound) {
+			return nil // the file was deleted in this commit
+		}
+		if err != nil {
+			return err
+		}
+		revisions = append(revisions, gitRevision{Name: c.Hash.String()[:7], Date: c.Committer.When, File: file})
+		return nil
+	})
+	if err != nil {
+		return revisions, fmt.Errorf("error reading git log: %v", err)
+	}
+
+	return revisions, nil
+}
+
+// gitTagRevisions returns the versions of the file at each tag that contains it.
+// Annotated tags are dated by the tag, lightweight tags by the commit.
+func gitTagRevisions(repo *git.Repository, relPath string) ([]gitRevision, error) {
+	var revisions []gitRevision
+
+	refs, err := repo.Tags()
+	if err != nil {
+		return revisions, fmt.Errorf("error reading git tags: %v", err)
+	}
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		name := ref.Name().Short()
+		var commit *object.Commit
+		var date time.Time
+
+		if tag, err := repo.TagObject(ref.Hash()); err == nil {
+			commit, err = tag.Commit()
+			if errors.Is(err, object.ErrUnsupportedObject) {
+				return nil // tag of a tree or blob
+			}
+			if err != nil {
+				return err
+			}
+			date = tag.Tagger.When
+		} else if errors.Is(err, plumbing.ErrObjectNotFound) {
+			commit, err = repo.CommitObject(ref.Hash())
+			if err != nil {
+				return nil // lightweight tag of a tree or blob
+			}
+			date = commit.Committer.When
+		} else {
+			return err
+		}
+
+		file, err := commit.File(relPath)
+		if errors.Is(err, object.ErrFileNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		revisions = append(revisions, gitRevision{Name: name, Date: date, File: file})
+		return nil
+	})
+	if err != nil {
+		return revisions, fmt.Errorf("error reading git tags: %v", err)
+	}
+
+	return revisions, nil
+}
@@ -0,0 +1,178 @@
+package usecases
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+// commitRadar writes the radar file and commits it at the given time.
+func commitRadar(t *testing.T, repo *git.Repository, dir, content string, when time.Time) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, "radar", "radar.yaml"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatalf("Failed to open worktree: %v", err)
+	}
+	if _, err := worktree.Add("radar/radar.yaml"); err != nil {
+		t.Fatalf("Failed to add file: %v", err)
+	}
+	signature := &object.Signature{Name: "Test", Email: "test@example.com", When: when}
+	if _, err := worktree.Commit("Update radar", &git.CommitOptions{Author: signature, Committer: signature}); err != nil {
+		t.Fatalf("Failed to commit: %v", err)
+	}
+}
+
+func radarYAML(goRing string) string {
+	return `technologies:
+  - name: "Go"
+    ring: "` + goRing + `"
+    quadrant: "Languages"
+    description: "Programming language"
+`
+}
+
+func TestReadTechnologiesFromGit(t *testing.T) {
+	meta := core.DefaultMeta()
+	dir := t.TempDir()
+	repo, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatalf("Failed to init repository: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "radar"), 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("radar"), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	day := func(d, h int) time.Time { return time.Date(2024, 1, d, h, 0, 0, 0, time.UTC) }
+	commitRadar(t, repo, dir, radarYAML("Assess"), day(1, 10))
+	commitRadar(t, repo, dir, radarYAML("Trial"), day(2, 10))
+	commitRadar(t, repo, dir, radarYAML("Adopt"), day(2, 12)) // same day, replaces Trial
+	head, _ := repo.Head()
+	if _, err := repo.CreateTag("v1", head.Hash(), &git.CreateTagOptions{
+		Tagger:  &object.Signature{Name: "Test", Email: "test@example.com", When: day(5, 10)},
+		Message: "v1",
+	}); err != nil {
+		t.Fatalf("Failed to create tag: %v", err)
+	}
+
+	// An unrelated commit doesn't add a snapshot
+	worktree, _ := repo.Worktree()
+	worktree.Add("README.md")
+	signature := &object.Signature{Name: "Test", Email: "test@example.com", When: day(3, 10)}
+	if _, err := worktree.Commit("Add readme", &git.CommitOptions{Author: signature, Committer: signature}); err != nil {
+		t.Fatalf("Failed to commit: %v", err)
+	}
+
+	filePath := filepath.Join(dir, "radar", "radar.yaml")
+	files, _, err := ReadTechnologiesFromGit(filePath, meta, false, ReadOptions{})
+	if err != nil {
+		t.Fatalf("ReadTechnologiesFromGit failed: %v", err)
+	}
+	if len(files) != 2 || files[0].Date != "20240101" || files[1].Date != "20240102" {
+		t.Fatalf("Expected snapshots of 20240101 and 20240102, got %+v", files)
+	}
+	if !files[0].Technologies[0].IsNew {
+		t.Error("Expected Go to be new in the first snapshot")
+	}
+	goTech := files[1].Technologies[0]
+	if goTech.Ring != "Adopt" || !goTech.IsMoved || goTech.PreviousRing != "Assess" {
+		t.Errorf("Expected Go moved from Assess to Adopt, got %+v", goTech)
+	}
+
+	files, _, err = ReadTechnologiesFromGit(filePath, meta, true, ReadOptions{})
+	if err != nil {
+		t.Fatalf("ReadTechnologiesFromGit with tags failed: %v", err)
+	}
+	if len(files) != 1 || files[0].Date != "20240105" || files[0].Technologies[0].Ring != "Adopt" {
+		t.Errorf("Expected one snapshot of tag v1, got %+v", files)
+	}
+
+	if _, _, err := ReadTechnologiesFromGit(filepath.Join(dir, "missing.yaml"), meta, false, ReadOptions{}); err == nil {
+		t.Error("Expected error for file without history, got nil")
+	}
+	if _, _, err := ReadTechnologiesFromGit(filepath.Join(t.TempDir(), "radar.yaml"), meta, false, ReadOptions{}); err == nil {
+		t.Error("Expected error for file outside of a repository, got nil")
+	}
+}
+
+func TestReadTechnologiesFromGitInvalidRevision(t *testing.T) {
+	dir := t.TempDir()
+	repo, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatalf("Failed to init repository: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "radar"), 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	commitRadar(t, repo, dir, radarYAML("Sometimes"), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	_, _, err = ReadTechnologiesFromGit(filepath.Join(dir, "radar", "radar.yaml"), core.DefaultMeta(), false, ReadOptions{})
+	if err == nil {
+		t.Fatal("Expected error for invalid ring, got nil")
+	}
+
+	// Invalid older revisions are skipped once fixed
+	commitRadar(t, repo, dir, radarYAML("Adopt"), time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	files, skipped, err := ReadTechnologiesFromGit(filepath.Join(dir, "radar", "radar.yaml"), core.DefaultMeta(), false, ReadOptions{})
+	if err != nil {
+		t.Fatalf("ReadTechnologiesFromGit failed: %v", err)
+	}
+	if len(files) != 1 || files[0].Date != "20240201" {
+		t.Errorf("Expected only the fixed revision, got %+v", files)
+	}
+	if len(skipped) != 1 || !skipped[0].IsWarning() || !strings.Contains(skipped[0].Message, "Sometimes") {
+		t.Errorf("Expected a warning for the invalid revision, got %+v", skipped)
+	}
+}
+
+func TestValidateRadarFromGit(t *testing.T) {
+	dir := t.TempDir()
+	repo, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatalf("Failed to init repository: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "radar"), 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	filePath := filepath.Join(dir, "radar", "radar.yaml")
+
+	meta := core.DefaultMeta()
+	meta.Rings[1].MaxAge = "1"
+	commitRadar(t, repo, dir, radarYAML("Trial"), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	commitRadar(t, repo, dir, radarYAML("Trial")+"  - name: \"Rust\"\n    ring: \"Adopt\"\n    quadrant: \"Languages\"\n    description: \"Systems language\"\n", time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC))
+
+	report, err := ValidateRadarFromGit(filePath, meta, false, time.Now(), false)
+	if err != nil {
+		t.Fatalf("ValidateRadarFromGit failed: %v", err)
+	}
+	if len(report.Files) != 1 || report.Files[0].File != filePath {
+		t.Fatalf("Expected the working tree file to be reported, got %+v", report.Files)
+	}
+	if report.ErrorCount() != 0 || report.WarningCount() != 1 || report.Files[0].Errors[0].Rule != core.RuleMaxAge {
+		t.Errorf("Expected a maxAge warning only, got %+v", report.Files[0].Errors)
+	}
+
+	// Uncommitted changes of the file are validated
+	if err := os.WriteFile(filePath, []byte(radarYAML("Never")), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	report, err = ValidateRadarFromGit(filePath, meta, false, time.Now(), false)
+	if err != nil {
+		t.Fatalf("ValidateRadarFromGit failed: %v", err)
+	}
+	if report.ErrorCount() == 0 {
+		t.Error("Expected an error for the invalid ring in the working tree")
+	}
+}
@@ -1,9 +1,12 @@
 package usecases
 
 import (
+	"errors"
+	"fmt"
 	"log"
 	"os"
 	"path/filepath"
+	"reflect"
 
 	"gopkg.in/yaml.v3"
 
@@ -11,8 +14,11 @@ import (
 )
 
 // ReadMeta reads meta data from file.
-// If metaPath is empty, searches for meta.yaml in inputDir.
-func ReadMeta(metaPath, inputDir string, verbose bool) (core.Meta, error) {
+// If metaPath is empty, searches for meta.yaml in inputDir and uses the default meta
+// when there is none. An explicitly given meta file that is missing, a meta file that
+// can't be parsed and an invalid meta configuration are errors.
+// Unknown keys in the meta file are rejected unless lenient is set.
+func ReadMeta(metaPath, inputDir string, verbose, lenient bool) (core.Meta, error) {
 	// Determine meta file path
 	filePath := metaPath
 
@@ -37,16 +43,36 @@ func ReadMeta(metaPath, inputDir string, verbose bool) (core.Meta, error) {
 	// Try to read the file
 	data, err := os.ReadFile(filePath)
 	if err != nil {
-		log.Printf("Using default meta: failed to read meta file '%s': %v", filePath, err)
-		return core.DefaultMeta(), nil
+		if metaPath == "" && errors.Is(err, os.ErrNotExist) {
+			log.Printf("Using default meta: no meta file '%s'", filePath)
+			return core.DefaultMeta(), nil
+		}
+		return core.Meta{}, fmt.Errorf("failed to read meta file '%s': %v", filePath, err)
 	}
 
 	metaFile := core.MetaFile{}
-	if err := yaml.Unmarshal(data, &metaFile); err != nil {
-		log.Printf("Using default meta: failed to parse meta file '%s': %v", filePath, err)
-		return core.DefaultMeta(), nil
+	var doc yaml.Node
+	err = yaml.Unmarshal(data, &doc)
+	if err == nil {
+		err = doc.Decode(&metaFile)
+	}
+	if err != nil {
+		return core.Meta{}, fmt.Errorf("failed to parse meta file '%s': %v", filePath, err)
+	}
+
+	// Reject typos in keys instead of silently ignoring them
+	unknown, err := unknownFields(&doc, reflect.TypeOf(metaFile))
+	if err != nil {
+		return core.Meta{}, fmt.Errorf("failed to parse meta file '%s': %v", filePath, err)
+	}
+	if len(unknown) > 0 && !lenient {
+		key := unknown[0]
+		return core.Meta{}, fmt.Errorf("%s:%d:%d: %s", filePath, key.Node.Line, key.Node.Column, key.Message("meta"))
 	}
 
 	meta := core.NewMetaFromFile(metaFile)
+	if err := meta.Validate(); err != nil {
+		return core.Meta{}, fmt.Errorf("%s: %v", filePath, err)
+	}
 	return meta, nil
 }
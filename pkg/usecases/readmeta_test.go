@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/ekalinin/terago/pkg/core"
@@ -21,9 +22,9 @@ func TestReadMeta(t *testing.T) {
 	defaultMeta := core.DefaultMeta()
 
 	// Test with empty file path and no input dir - should return defaults
-	meta, err := ReadMeta("", "", false)
+	meta, err := ReadMeta("", "", false, false)
 	if err != nil {
-		t.Errorf("ReadMeta(\"\", \"\", false) returned error: %v", err)
+		t.Errorf("ReadMeta(\"\", \"\", false, false) returned error: %v", err)
 	}
 
 	// Check that we got the default values
@@ -43,18 +44,22 @@ func TestReadMeta(t *testing.T) {
 		t.Errorf("Expected %d rings, got %d", len(defaultMeta.Rings), len(meta.Rings))
 	}
 
-	// Test with non-existent file - should return defaults
-	meta, err = ReadMeta("non-existent-file.yaml", "", false)
-	if err != nil {
-		t.Errorf("ReadMeta(\"non-existent-file.yaml\", \"\", false) returned error: %v", err)
+	// Test with explicitly given non-existent file - should fail
+	_, err = ReadMeta("non-existent-file.yaml", "", false, false)
+	if err == nil {
+		t.Error("ReadMeta(\"non-existent-file.yaml\", \"\", false, false) expected error, got nil")
 	}
 
-	// Check that we got the default values
+	// Test with input dir without meta.yaml - should return defaults
+	meta, err = ReadMeta("", t.TempDir(), false, false)
+	if err != nil {
+		t.Errorf("ReadMeta with input dir without meta.yaml returned error: %v", err)
+	}
 	if meta.Title != defaultMeta.Title {
 		t.Errorf("Expected title %s, got %s", defaultMeta.Title, meta.Title)
 	}
 
-	// Test with invalid YAML file - should return defaults
+	// Test with invalid YAML file - should fail
 	invalidYamlFile := "test-invalid.yaml"
 	invalidYamlContent := `
 title: "Test Radar"
@@ -69,14 +74,9 @@ invalid: yaml: content:
 	}
 	defer os.Remove(invalidYamlFile)
 
-	meta, err = ReadMeta(invalidYamlFile, "", false)
-	if err != nil {
-		t.Errorf("ReadMeta with invalid YAML returned error: %v", err)
-	}
-
-	// Should still return defaults
-	if meta.Title != defaultMeta.Title {
-		t.Errorf("Expected title %s, got %s", defaultMeta.Title, meta.Title)
+	_, err = ReadMeta(invalidYamlFile, "", false, false)
+	if err == nil {
+		t.Error("ReadMeta with invalid YAML expected error, got nil")
 	}
 
 	// Test with empty meta path but valid input dir with meta.yaml
@@ -102,7 +102,7 @@ rings:
 		t.Fatalf("Failed to create test meta file: %v", err)
 	}
 
-	meta, err = ReadMeta("", tmpDir, false)
+	meta, err = ReadMeta("", tmpDir, false, false)
 	if err != nil {
 		t.Errorf("ReadMeta with input dir returned error: %v", err)
 	}
@@ -116,3 +116,33 @@ rings:
 		t.Errorf("Expected description 'Test Description', got %s", meta.Description)
 	}
 }
+
+func TestReadMetaInvalidMeta(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer func() {
+		log.SetOutput(os.Stderr)
+	}()
+
+	tmpDir := t.TempDir()
+	metaContent := `title: "Test Radar"
+fileNamePattern: "^(\\d{8}\\.yaml$"
+rings:
+  - name: "Adopt"
+    alias: "adopt"
+  - name: "Trial"
+    alias: "adopt"
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "meta.yaml"), []byte(metaContent), 0644); err != nil {
+		t.Fatalf("Failed to create test meta file: %v", err)
+	}
+
+	_, err := ReadMeta("", tmpDir, false, false)
+	if err == nil {
+		t.Fatal("Expected error for invalid meta, got nil")
+	}
+	for _, want := range []string{"duplicate ring name or alias 'adopt'", "invalid fileNamePattern"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected %q in error, got: %v", want, err)
+		}
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 
@@ -12,103 +14,281 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
-// GetRadarFiles returns a sorted list of YAML files that match the pattern from meta.
+// GetRadarFiles returns a list of technologies files (of any supported format)
+// and snapshot directories that match the pattern from meta, sorted by snapshot date
+// (see core.Meta.SnapshotTime) or by name when dates are unknown.
+// Drafts are included, names with the draft suffix are matched without it.
 func GetRadarFiles(inputDir string, meta core.Meta) ([]string, error) {
-	// Get all YAML files in the directory
-	files, err := filepath.Glob(filepath.Join(inputDir, "*.yaml"))
-	if err != nil {
-		return nil, fmt.Errorf("error reading directory: %v", err)
+	// Get all files with a supported extension in the directory
+	var files []string
+	for _, ext := range TechnologiesFileExtensions() {
+		matches, err := filepath.Glob(filepath.Join(inputDir, "*"+ext))
+		if err != nil {
+			return nil, fmt.Errorf("error reading directory: %v", err)
+		}
+		files = append(files, matches...)
 	}
 
-	// Get file name pattern from meta or use default YYYYMMDD.yaml pattern
-	datePattern := regexp.MustCompile(meta.FileNamePattern)
+	// Get file name pattern from meta or use default YYYYMMDD.<ext> pattern
+	datePattern, err := regexp.Compile(meta.FileNamePattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fileNamePattern '%s': %v", meta.FileNamePattern, err)
+	}
 	var validFiles []string
 	for _, file := range files {
-		baseName := filepath.Base(file)
+		baseName, _ := trimDraftSuffix(filepath.Base(file))
 		if datePattern.MatchString(baseName) {
 			validFiles = append(validFiles, file)
 		}
 	}
 
-	// Sort files by date (filename)
-	sort.Slice(validFiles, func(i, j int) bool {
-		fileNameI := filepath.Base(validFiles[i])
-		fileNameJ := filepath.Base(validFiles[j])
-		return strings.Compare(fileNameI, fileNameJ) < 0
+	// Add snapshot directories
+	entries, err := os.ReadDir(inputDir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("error reading directory: %v", err)
+	}
+	for _, entry := range entries {
+		name, _ := trimDraftSuffix(entry.Name())
+		if entry.IsDir() && isSnapshotDir(name, datePattern) {
+			validFiles = append(validFiles, filepath.Join(inputDir, entry.Name()))
+		}
+	}
+
+	// Sort files by date
+	snapshots := make(map[string]core.TechnologiesFile, len(validFiles))
+	for _, file := range validFiles {
+		snapshot, err := snapshotOf(file, meta)
+		if err != nil {
+			return nil, err
+		}
+		snapshots[file] = snapshot
+	}
+	sort.SliceStable(validFiles, func(i, j int) bool {
+		a, b := snapshots[validFiles[i]], snapshots[validFiles[j]]
+		if a.Date == b.Date && a.Time.Equal(b.Time) {
+			return filepath.Base(validFiles[i]) < filepath.Base(validFiles[j])
+		}
+		return a.Before(b)
 	})
 
 	return validFiles, nil
 }
 
+// snapshotOf returns an empty snapshot with the date and time of a technologies file
+// or snapshot directory. The date is the name without extension and draft suffix.
+func snapshotOf(filePath string, meta core.Meta) (core.TechnologiesFile, error) {
+	name, draft := trimDraftSuffix(filepath.Base(filePath))
+	snapshot := core.TechnologiesFile{Date: snapshotDate(name), Draft: draft}
+
+	// Snapshot directories match the file name pattern with a .yaml extension
+	matchName := name
+	if info, err := os.Stat(filePath); err == nil && info.IsDir() {
+		matchName = name + ".yaml"
+	}
+
+	t, err := meta.SnapshotTime(matchName, snapshot.Date)
+	if err != nil {
+		return snapshot, err
+	}
+	snapshot.Time = t
+	return snapshot, nil
+}
+
+// ReadOptions control how technologies files are read.
+type ReadOptions struct {
+	// Lenient allows unknown keys in technology files
+	Lenient bool
+	// IncludeDrafts reads draft snapshots along with published ones
+	IncludeDrafts bool
+}
+
 // ReadTechnologiesFiles reads all Technologies files in the specified directory.
-func ReadTechnologiesFiles(inputDir string, meta core.Meta) ([]core.TechnologiesFile, error) {
+// Draft snapshots are skipped unless opts.IncludeDrafts is set.
+func ReadTechnologiesFiles(inputDir string, meta core.Meta, opts ReadOptions) ([]core.TechnologiesFile, error) {
 	var technologiesFiles []core.TechnologiesFile
 
-	// Get all valid YAML files
+	// Get all valid technologies files
 	validFiles, err := GetRadarFiles(inputDir, meta)
 	if err != nil {
 		return technologiesFiles, err
 	}
 
 	// Process each file in order
-	var previousTechnologies []core.Technology
 	for _, file := range validFiles {
-		fileName := filepath.Base(file)
-		// fmt.Printf("Processing file: %s\n", fileName)
-
-		// Extract date from filename (without .yaml extension)
-		dateStr := strings.TrimSuffix(fileName, ".yaml")
+		// Extract date from filename (without extension)
+		snapshot, err := snapshotOf(file, meta)
+		if err != nil {
+			return technologiesFiles, err
+		}
 
 		// Parse YAML file
-		technologiesFile, err := readTechnologiesFile(file, meta)
+		technologiesFile, err := readTechnologiesFile(file, meta, opts.Lenient)
 		if err != nil {
 			return technologiesFiles, fmt.Errorf("error processing file %s: %v", file, err)
 		}
 
 		// Set the date in the file
-		technologiesFile.Date = dateStr
-
-		// Compare with previous period to identify changes
-		if previousTechnologies != nil {
-			markChanges(&technologiesFile.Technologies, previousTechnologies)
-		} else {
-			// If this is the first file, mark all as new
-			for i := range technologiesFile.Technologies {
-				technologiesFile.Technologies[i].IsNew = true
-			}
+		technologiesFile.Date = snapshot.Date
+		technologiesFile.Time = snapshot.Time
+		technologiesFile.Draft = technologiesFile.Draft || snapshot.Draft
+		if technologiesFile.Draft && !opts.IncludeDrafts {
+			continue
 		}
 
-		// Save current technologies for next comparison
-		previousTechnologies = technologiesFile.Technologies
-
 		// Add to result
 		technologiesFiles = append(technologiesFiles, technologiesFile)
 	}
 
+	// Compare each period with the previous one to identify changes
+	markAllChanges(technologiesFiles)
+
 	return technologiesFiles, nil
 }
 
-// readTechnologiesFile reads and validates a single technologies YAML file.
-func readTechnologiesFile(filePath string, meta core.Meta) (core.TechnologiesFile, error) {
-	var technologiesFile core.TechnologiesFile
+// markAllChanges marks changes of each snapshot compared to the previous one.
+// Files must be sorted by date, all technologies of the first snapshot are new.
+func markAllChanges(files []core.TechnologiesFile) {
+	for i := range files {
+		if i == 0 {
+			for j := range files[i].Technologies {
+				files[i].Technologies[j].IsNew = true
+			}
+			continue
+		}
+		markChanges(&files[i].Technologies, files[i-1].Technologies)
+	}
+}
 
-	// Read file content
-	data, err := os.ReadFile(filePath)
+// readTechnologiesFile reads and validates a single technologies file.
+// Unknown keys are rejected unless lenient is set.
+func readTechnologiesFile(filePath string, meta core.Meta, lenient bool) (core.TechnologiesFile, error) {
+	technologiesFile, schemaErrs, err := parseTechnologiesFile(filePath)
 	if err != nil {
-		return technologiesFile, fmt.Errorf("error reading file: %v", err)
+		return technologiesFile, err
 	}
+	return technologiesFile, checkTechnologiesFile(filePath, technologiesFile, schemaErrs, meta, lenient)
+}
 
-	// Parse YAML content
-	if err := yaml.Unmarshal(data, &technologiesFile); err != nil {
-		return technologiesFile, fmt.Errorf("error parsing YAML: %v", err)
+// checkTechnologiesFile rejects unknown keys (unless lenient is set) and
+// invalid rings and quadrants of a parsed technologies file.
+func checkTechnologiesFile(filePath string, technologiesFile core.TechnologiesFile, schemaErrs core.ValidationErrors, meta core.Meta, lenient bool) error {
+	if !lenient && len(schemaErrs) > 0 {
+		return fmt.Errorf("schema error in %s", schemaErrs[0].String())
 	}
 
 	// Validate rings and quadrants
 	if err := technologiesFile.ValidateRingsAndQuadrants(meta); err != nil {
-		return technologiesFile, fmt.Errorf("validation error in file %s: %v", filePath, err)
+		return fmt.Errorf("validation error in file %s: %v", filePath, err)
+	}
+
+	return nil
+}
+
+// parseTechnologiesFile reads and parses a single technologies file or snapshot directory
+// without validation. The decoder is chosen by the file extension. The file is parsed through a YAML node tree
+// so each technology keeps its source position.
+// Unknown keys are returned as schema errors.
+func parseTechnologiesFile(filePath string) (core.TechnologiesFile, core.ValidationErrors, error) {
+	// Snapshot in the directory layout
+	if info, err := os.Stat(filePath); err == nil && info.IsDir() {
+		return parseTechnologiesDir(filePath)
+	}
+
+	// Read file content
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return core.TechnologiesFile{}, nil, fmt.Errorf("error reading file: %v", err)
 	}
 
-	return technologiesFile, nil
+	return parseTechnologies(filePath, data)
+}
+
+// parseTechnologies parses the content of a technologies file without validation,
+// the decoder is chosen by the extension of filePath.
+func parseTechnologies(filePath string, data []byte) (core.TechnologiesFile, core.ValidationErrors, error) {
+	var technologiesFile core.TechnologiesFile
+	var schemaErrs core.ValidationErrors
+
+	// Parse content
+	decode, ok := technologiesDecoder(filePath)
+	if !ok {
+		return technologiesFile, nil, fmt.Errorf("unsupported file format '%s' (use %s)",
+			filepath.Ext(filePath), strings.Join(TechnologiesFileExtensions(), ", "))
+	}
+	doc, err := decode(data)
+	if err != nil {
+		return technologiesFile, nil, err
+	}
+	if doc.Kind == 0 {
+		return technologiesFile, nil, nil // empty file
+	}
+	if err := doc.Decode(&technologiesFile); err != nil {
+		return technologiesFile, nil, fmt.Errorf("error decoding technologies: %v", err)
+	}
+
+	// Unknown keys of technologies are reported per technology below
+	root := documentRoot(doc)
+	unknown, err := unknownFields(doc, reflect.TypeOf(technologiesFile))
+	if err != nil {
+		return technologiesFile, nil, err
+	}
+	known := yamlFieldNames(reflect.TypeOf(technologiesFile))
+	for _, key := range unknown {
+		if slices.Contains(root.Content, key.Node) {
+			key.Known = known
+			schemaErrs = append(schemaErrs, schemaError(filePath, "", key, "file"))
+		}
+	}
+
+	// Keep source positions of technologies and their fields, check their keys
+	if technologies := mappingValue(root, "technologies"); technologies != nil && technologies.Kind == yaml.SequenceNode {
+		for i, item := range technologies.Content {
+			if i >= len(technologiesFile.Technologies) {
+				break
+			}
+			tech := &technologiesFile.Technologies[i]
+			tech.Positions.Entry = core.Position{Line: item.Line, Column: item.Column}
+			fields := map[string]*core.Position{
+				"name":        &tech.Positions.Name,
+				"ring":        &tech.Positions.Ring,
+				"quadrant":    &tech.Positions.Quadrant,
+				"description": &tech.Positions.Description,
+			}
+			for key, pos := range fields {
+				if value := mappingValue(item, key); value != nil {
+					*pos = core.Position{Line: value.Line, Column: value.Column}
+				}
+			}
+
+			context := fmt.Sprintf("technology '%s'", tech.Name)
+			if tech.Name == "" {
+				context = fmt.Sprintf("technology #%d", i+1)
+			}
+			unknown, err := unknownFields(item, reflect.TypeOf(*tech))
+			if err != nil {
+				return technologiesFile, nil, err
+			}
+			for _, key := range unknown {
+				schemaErrs = append(schemaErrs, schemaError(filePath, tech.Name, key, context))
+			}
+		}
+	}
+
+	return technologiesFile, schemaErrs, nil
+}
+
+// schemaError returns a validation error for an unknown key.
+func schemaError(filePath, technology string, key unknownKey, context string) core.ValidationError {
+	return core.ValidationError{
+		File:       filePath,
+		Technology: technology,
+		Field:      key.Node.Value,
+		Rule:       core.RuleUnknownField,
+		Severity:   core.SeverityError,
+		Message:    key.Message(context),
+		Line:       key.Node.Line,
+		Column:     key.Node.Column,
+	}
 }
 
 // markChanges compares current technologies with previous ones and marks changes.
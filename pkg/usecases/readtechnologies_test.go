@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/ekalinin/terago/pkg/core"
@@ -27,13 +28,13 @@ func TestReadTechnologiesFiles(t *testing.T) {
 
 	validTechFile := validInputDir + "/20231201.yaml"
 	// Create valid YAML file
-	err = os.WriteFile(validTechFile, []byte("date: \"20231201\"\ntechnologies:\n  - name: \"Go\"\n    ring: \"Adopt\"\n    quadrant: \"Languages\"\n    description: \"Go programming language\"\n  - name: \"React\"\n    ring: \"Trial\"\n    quadrant: \"Frameworks\"\n    description: \"React framework\""), 0644)
+	err = os.WriteFile(validTechFile, []byte("technologies:\n  - name: \"Go\"\n    ring: \"Adopt\"\n    quadrant: \"Languages\"\n    description: \"Go programming language\"\n  - name: \"React\"\n    ring: \"Trial\"\n    quadrant: \"Frameworks\"\n    description: \"React framework\""), 0644)
 	if err != nil {
 		t.Fatalf("Failed to create test file: %v", err)
 	}
 
 	// Test with valid files - should succeed
-	files, err := ReadTechnologiesFiles(validInputDir, meta)
+	files, err := ReadTechnologiesFiles(validInputDir, meta, ReadOptions{})
 	if err != nil {
 		t.Errorf("ReadTechnologiesFiles with valid files returned error: %v", err)
 	}
@@ -55,13 +56,13 @@ func TestReadTechnologiesFiles(t *testing.T) {
 
 	invalidRingFile := invalidRingDir + "/20231201.yaml"
 	// Create YAML file with invalid ring
-	err = os.WriteFile(invalidRingFile, []byte("date: \"20231201\"\ntechnologies:\n  - name: \"Invalid Technology\"\n    ring: \"InvalidRing\"\n    quadrant: \"Languages\"\n    description: \"Technology with invalid ring\""), 0644)
+	err = os.WriteFile(invalidRingFile, []byte("technologies:\n  - name: \"Invalid Technology\"\n    ring: \"InvalidRing\"\n    quadrant: \"Languages\"\n    description: \"Technology with invalid ring\""), 0644)
 	if err != nil {
 		t.Fatalf("Failed to create test file: %v", err)
 	}
 
 	// Test with invalid ring - should fail
-	_, err = ReadTechnologiesFiles(invalidRingDir, meta)
+	_, err = ReadTechnologiesFiles(invalidRingDir, meta, ReadOptions{})
 	if err == nil {
 		t.Error("ReadTechnologiesFiles with invalid ring should return error")
 	}
@@ -75,13 +76,13 @@ func TestReadTechnologiesFiles(t *testing.T) {
 
 	invalidQuadrantFile := invalidQuadrantDir + "/20231201.yaml"
 	// Create YAML file with invalid quadrant
-	err = os.WriteFile(invalidQuadrantFile, []byte("date: \"20231201\"\ntechnologies:\n  - name: \"Invalid Technology\"\n    ring: \"Adopt\"\n    quadrant: \"InvalidQuadrant\"\n    description: \"Technology with invalid quadrant\""), 0644)
+	err = os.WriteFile(invalidQuadrantFile, []byte("technologies:\n  - name: \"Invalid Technology\"\n    ring: \"Adopt\"\n    quadrant: \"InvalidQuadrant\"\n    description: \"Technology with invalid quadrant\""), 0644)
 	if err != nil {
 		t.Fatalf("Failed to create test file: %v", err)
 	}
 
 	// Test with invalid quadrant - should fail
-	_, err = ReadTechnologiesFiles(invalidQuadrantDir, meta)
+	_, err = ReadTechnologiesFiles(invalidQuadrantDir, meta, ReadOptions{})
 	if err == nil {
 		t.Error("ReadTechnologiesFiles with invalid quadrant should return error")
 	}
@@ -108,26 +109,26 @@ func TestReadTechnologiesFilesWithCustomPattern(t *testing.T) {
 
 	// Create valid files with custom pattern
 	validFile1 := customDir + "/radar-2023-12-01.yaml"
-	err = os.WriteFile(validFile1, []byte("date: \"2023-12-01\"\ntechnologies:\n  - name: \"Go\"\n    ring: \"Adopt\"\n    quadrant: \"Languages\"\n    description: \"Go programming language\""), 0644)
+	err = os.WriteFile(validFile1, []byte("technologies:\n  - name: \"Go\"\n    ring: \"Adopt\"\n    quadrant: \"Languages\"\n    description: \"Go programming language\""), 0644)
 	if err != nil {
 		t.Fatalf("Failed to create test file: %v", err)
 	}
 
 	validFile2 := customDir + "/radar-2023-12-15.yaml"
-	err = os.WriteFile(validFile2, []byte("date: \"2023-12-15\"\ntechnologies:\n  - name: \"React\"\n    ring: \"Trial\"\n    quadrant: \"Frameworks\"\n    description: \"React framework\""), 0644)
+	err = os.WriteFile(validFile2, []byte("technologies:\n  - name: \"React\"\n    ring: \"Trial\"\n    quadrant: \"Frameworks\"\n    description: \"React framework\""), 0644)
 	if err != nil {
 		t.Fatalf("Failed to create test file: %v", err)
 	}
 
 	// Create file that doesn't match the pattern (should be ignored)
 	invalidPatternFile := customDir + "/20231201.yaml"
-	err = os.WriteFile(invalidPatternFile, []byte("date: \"20231201\"\ntechnologies:\n  - name: \"Python\"\n    ring: \"Adopt\"\n    quadrant: \"Languages\"\n    description: \"Python programming language\""), 0644)
+	err = os.WriteFile(invalidPatternFile, []byte("technologies:\n  - name: \"Python\"\n    ring: \"Adopt\"\n    quadrant: \"Languages\"\n    description: \"Python programming language\""), 0644)
 	if err != nil {
 		t.Fatalf("Failed to create test file: %v", err)
 	}
 
 	// Test with custom pattern
-	files, err := ReadTechnologiesFiles(customDir, meta)
+	files, err := ReadTechnologiesFiles(customDir, meta, ReadOptions{})
 	if err != nil {
 		t.Errorf("ReadTechnologiesFiles with custom pattern returned error: %v", err)
 	}
@@ -170,26 +171,26 @@ func TestReadTechnologiesFilesWithDefaultPattern(t *testing.T) {
 
 	// Create files with default YYYYMMDD pattern
 	file1 := testDir + "/20231201.yaml"
-	err = os.WriteFile(file1, []byte("date: \"20231201\"\ntechnologies:\n  - name: \"Go\"\n    ring: \"Adopt\"\n    quadrant: \"Languages\"\n    description: \"Go programming language\""), 0644)
+	err = os.WriteFile(file1, []byte("technologies:\n  - name: \"Go\"\n    ring: \"Adopt\"\n    quadrant: \"Languages\"\n    description: \"Go programming language\""), 0644)
 	if err != nil {
 		t.Fatalf("Failed to create test file: %v", err)
 	}
 
 	file2 := testDir + "/20231215.yaml"
-	err = os.WriteFile(file2, []byte("date: \"20231215\"\ntechnologies:\n  - name: \"React\"\n    ring: \"Trial\"\n    quadrant: \"Frameworks\"\n    description: \"React framework\""), 0644)
+	err = os.WriteFile(file2, []byte("technologies:\n  - name: \"React\"\n    ring: \"Trial\"\n    quadrant: \"Frameworks\"\n    description: \"React framework\""), 0644)
 	if err != nil {
 		t.Fatalf("Failed to create test file: %v", err)
 	}
 
 	// Create file with invalid pattern (should be ignored)
 	invalidFile := testDir + "/radar-2023.yaml"
-	err = os.WriteFile(invalidFile, []byte("date: \"2023\"\ntechnologies:\n  - name: \"Python\"\n    ring: \"Adopt\"\n    quadrant: \"Languages\"\n    description: \"Python\""), 0644)
+	err = os.WriteFile(invalidFile, []byte("technologies:\n  - name: \"Python\"\n    ring: \"Adopt\"\n    quadrant: \"Languages\"\n    description: \"Python\""), 0644)
 	if err != nil {
 		t.Fatalf("Failed to create test file: %v", err)
 	}
 
 	// Test with default pattern
-	files, err := ReadTechnologiesFiles(testDir, meta)
+	files, err := ReadTechnologiesFiles(testDir, meta, ReadOptions{})
 	if err != nil {
 		t.Errorf("ReadTechnologiesFiles with default pattern returned error: %v", err)
 	}
@@ -454,3 +455,51 @@ func TestMarkChangesDeletedTechnologyPreservesOriginalData(t *testing.T) {
 		t.Error("Expected IsMoved=false for deleted technology")
 	}
 }
+
+func TestGetRadarFilesInvalidPattern(t *testing.T) {
+	meta := core.DefaultMeta()
+	meta.FileNamePattern = `^(\d{8}\.yaml$`
+
+	_, err := GetRadarFiles(t.TempDir(), meta)
+	if err == nil || !strings.Contains(err.Error(), "invalid fileNamePattern") {
+		t.Errorf("Expected invalid pattern error, got %v", err)
+	}
+}
+
+func TestReadTechnologiesFilesWithDateLayout(t *testing.T) {
+	meta := core.DefaultMeta()
+	meta.FileNamePattern = `^radar-(?P<date>[A-Z][a-z]{2}-\d{4})\.yaml$`
+	meta.DateLayout = "Jan-2006"
+
+	tempDir := t.TempDir()
+	content := "technologies:\n  - name: \"Go\"\n    ring: \"Adopt\"\n    quadrant: \"Languages\"\n    description: \"Go\"\n"
+	for _, name := range []string{"radar-Mar-2024.yaml", "radar-Dec-2023.yaml", "radar-Feb-2024.yaml"} {
+		if err := os.WriteFile(tempDir+"/"+name, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create test file: %v", err)
+		}
+	}
+
+	files, err := ReadTechnologiesFiles(tempDir, meta, ReadOptions{})
+	if err != nil {
+		t.Fatalf("ReadTechnologiesFiles failed: %v", err)
+	}
+	var dates []string
+	for _, file := range files {
+		dates = append(dates, file.Date+"@"+file.Time.Format("2006-01-02"))
+	}
+	expected := "radar-Dec-2023@2023-12-01 radar-Feb-2024@2024-02-01 radar-Mar-2024@2024-03-01"
+	if strings.Join(dates, " ") != expected {
+		t.Errorf("Expected %s, got %v", expected, dates)
+	}
+	if !files[0].Technologies[0].IsNew || files[1].Technologies[0].IsNew {
+		t.Error("Expected Go to be new only in the earliest snapshot")
+	}
+
+	if err := os.WriteFile(tempDir+"/radar-Foo-2024.yaml", []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+	meta.FileNamePattern = `^radar-(?P<date>\w{3}-\d{4})\.yaml$`
+	if _, err := GetRadarFiles(tempDir, meta); err == nil || !strings.Contains(err.Error(), "radar-Foo-2024.yaml") {
+		t.Errorf("Expected error for date not matching layout, got %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package usecases
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+// ReadWorkspace reads a workspace file listing several radars.
+// Input, meta and template paths of the radars and the aggregated radar are resolved
+// relative to the workspace file.
+// Unknown keys are rejected unless lenient is set.
+func ReadWorkspace(filePath string, lenient bool) (core.Workspace, error) {
+	var workspace core.Workspace
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return workspace, fmt.Errorf("failed to read workspace file '%s': %v", filePath, err)
+	}
+
+	var doc yaml.Node
+	err = yaml.Unmarshal(data, &doc)
+	if err == nil && doc.Kind != 0 {
+		err = doc.Decode(&workspace)
+	}
+	if err != nil {
+		return workspace, fmt.Errorf("failed to parse workspace file '%s': %v", filePath, err)
+	}
+
+	unknown, err := unknownFields(&doc, reflect.TypeOf(workspace))
+	if err != nil {
+		return workspace, fmt.Errorf("failed to parse workspace file '%s': %v", filePath, err)
+	}
+	if len(unknown) > 0 && !lenient {
+		key := unknown[0]
+		return workspace, fmt.Errorf("%s:%d:%d: %s", filePath, key.Node.Line, key.Node.Column, key.Message("workspace"))
+	}
+
+	if err := workspace.Validate(); err != nil {
+		return workspace, fmt.Errorf("%s: %v", filePath, err)
+	}
+
+	baseDir := filepath.Dir(filePath)
+	for i := range workspace.Radars {
+		r := &workspace.Radars[i]
+		r.Input = workspacePath(baseDir, r.Input)
+		r.Meta = workspacePath(baseDir, r.Meta)
+		r.Template = workspacePath(baseDir, r.Template)
+	}
+	if a := workspace.Aggregate; a != nil {
+		a.Meta = workspacePath(baseDir, a.Meta)
+		a.Template = workspacePath(baseDir, a.Template)
+	}
+
+	return workspace, nil
+}
+
+// workspacePath resolves a path relative to the workspace directory, empty paths stay empty.
+func workspacePath(baseDir, path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(baseDir, path)
+}
@@ -0,0 +1,105 @@
+package usecases
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+// OverdueTechnology represents a technology that stayed in a ring longer than the ring's maxAge.
+type OverdueTechnology struct {
+	Name     string `json:"name"`
+	Ring     string `json:"ring"`
+	Quadrant string `json:"quadrant"`
+	// Since is the date the technology entered the ring or was last reviewed
+	Since   string `json:"since"`
+	Periods int    `json:"periods"`
+	MaxAge  string `json:"maxAge"`
+	// Position of the ring in the latest snapshot
+	Position core.Position `json:"-"`
+}
+
+// String returns a human-readable description of the overdue technology.
+func (o OverdueTechnology) String() string {
+	return fmt.Sprintf("technology '%s' has been in ring '%s' since %s (%d period(s)), max age is %s",
+		o.Name, o.Ring, o.Since, o.Periods, o.MaxAge)
+}
+
+// FindOverdueTechnologies returns technologies of the latest snapshot that stayed in their ring
+// longer than the ring's maxAge from meta. Files must be sorted by date (as returned by ReadTechnologiesFiles).
+// The age is counted in snapshots or, for calendar units, from the date of the snapshot where
+// the technology entered the ring up to now. A reviewedAt date on the technology resets the clock;
+// an invalid one is ignored, as it is reported by validation.
+func FindOverdueTechnologies(files []core.TechnologiesFile, meta core.Meta, now time.Time) ([]OverdueTechnology, error) {
+	var overdue []OverdueTechnology
+	if len(files) == 0 {
+		return overdue, nil
+	}
+
+	for _, tech := range files[len(files)-1].Technologies {
+		if tech.IsDeleted {
+			continue
+		}
+		ring, ok := meta.FindRing(tech.Ring)
+		if !ok || ring.MaxAge == "" {
+			continue
+		}
+		maxAge, err := core.ParseMaxAge(ring.MaxAge)
+		if err != nil {
+			return nil, fmt.Errorf("ring '%s': %v", ring.Name, err)
+		}
+
+		history := GetTechnologyHistory(files, tech.Name)
+		if len(history.Rings) == 0 {
+			continue
+		}
+		current := history.Rings[len(history.Rings)-1]
+		entries := history.Entries[len(history.Entries)-current.Periods:]
+
+		since, sinceTime := current.From, current.FromTime
+		// An invalid reviewedAt is reported by validation (rule invalid-date) and ignored here
+		if reviewed, ok := parseReviewDate(tech.ReviewedAt); ok {
+			if from, ok := snapshotTime(current.From, current.FromTime); ok && reviewed.After(from) {
+				since = reviewed.Format("20060102")
+				sinceTime = reviewed
+				var reviewedEntries []HistoryEntry
+				for _, e := range entries {
+					if date, ok := snapshotTime(e.Date, e.Time); ok && !date.Before(reviewed) {
+						reviewedEntries = append(reviewedEntries, e)
+					}
+				}
+				entries = reviewedEntries
+			}
+		}
+
+		isOverdue := false
+		if maxAge.IsPeriods() {
+			isOverdue = len(entries) > maxAge.Count
+		} else if t, ok := snapshotTime(since, sinceTime); ok {
+			isOverdue = now.After(maxAge.Deadline(t))
+		}
+
+		if isOverdue {
+			overdue = append(overdue, OverdueTechnology{
+				Name:     tech.Name,
+				Ring:     ring.Name,
+				Quadrant: tech.Quadrant,
+				Since:    since,
+				Periods:  len(entries),
+				MaxAge:   maxAge.String(),
+				Position: tech.FieldPosition("ring"),
+			})
+		}
+	}
+
+	return overdue, nil
+}
+
+// parseReviewDate parses a review date in YYYY-MM-DD or YYYYMMDD format.
+func parseReviewDate(dateStr string) (time.Time, bool) {
+	if t, err := time.Parse("2006-01-02", dateStr); err == nil {
+		return t, true
+	}
+	return parseDate(dateStr)
+}
@@ -0,0 +1,73 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+func TestFindOverdueTechnologies(t *testing.T) {
+	meta := core.NewMeta("", "", nil, []core.Ring{
+		{Name: "Adopt", Alias: "adopt"},
+		{Name: "Trial", Alias: "trial", MaxAge: "6 months"},
+		{Name: "Assess", Alias: "assess", MaxAge: "2"},
+		{Name: "Hold", Alias: "hold"},
+	})
+
+	files := []core.TechnologiesFile{
+		{Date: "20240101", Technologies: []core.Technology{
+			{Name: "Kafka", Ring: "Assess", Quadrant: "Platforms"},
+			{Name: "Rust", Ring: "Assess", Quadrant: "Languages"},
+			{Name: "Svelte", Ring: "Trial", Quadrant: "Frameworks"},
+		}},
+		{Date: "20240401", Technologies: []core.Technology{
+			{Name: "Kafka", Ring: "Assess", Quadrant: "Platforms"},
+			{Name: "Rust", Ring: "Assess", Quadrant: "Languages"},
+			{Name: "Svelte", Ring: "Trial", Quadrant: "Frameworks"},
+			{Name: "Go", Ring: "Trial", Quadrant: "Languages"},
+		}},
+		{Date: "20240701", Technologies: []core.Technology{
+			{Name: "Kafka", Ring: "Assess", Quadrant: "Platforms"},
+			{Name: "Rust", Ring: "Assess", Quadrant: "Languages", ReviewedAt: "2024-06-15"},
+			{Name: "Svelte", Ring: "Trial", Quadrant: "Frameworks"},
+			{Name: "Go", Ring: "Trial", Quadrant: "Languages"},
+		}},
+	}
+
+	now := time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)
+	overdue, err := FindOverdueTechnologies(files, meta, now)
+	if err != nil {
+		t.Fatalf("FindOverdueTechnologies failed: %v", err)
+	}
+
+	// Kafka: 3 periods in Assess (max 2)
+	// Rust: reviewed in June, only 1 period counted since review
+	// Svelte: in Trial since January (max 6 months)
+	// Go: in Trial since April, less than 6 months
+	expected := []OverdueTechnology{
+		{Name: "Kafka", Ring: "Assess", Quadrant: "Platforms", Since: "20240101", Periods: 3, MaxAge: "2 periods"},
+		{Name: "Svelte", Ring: "Trial", Quadrant: "Frameworks", Since: "20240101", Periods: 3, MaxAge: "6 months"},
+	}
+	if len(overdue) != len(expected) {
+		t.Fatalf("Expected %d overdue technologies, got %+v", len(expected), overdue)
+	}
+	for i, o := range overdue {
+		if o != expected[i] {
+			t.Errorf("Overdue %d: expected %+v, got %+v", i, expected[i], o)
+		}
+	}
+
+	// Invalid reviewedAt is ignored, it's reported by validation
+	files[2].Technologies[1].ReviewedAt = "June"
+	if _, err := FindOverdueTechnologies(files, meta, now); err != nil {
+		t.Errorf("Expected invalid reviewedAt to be ignored, got %v", err)
+	}
+
+	// Invalid maxAge
+	meta.Rings[2].MaxAge = "soon"
+	files[2].Technologies[1].ReviewedAt = ""
+	if _, err := FindOverdueTechnologies(files, meta, now); err == nil {
+		t.Error("Expected error for invalid maxAge, got nil")
+	}
+}
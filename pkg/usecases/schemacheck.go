@@ -0,0 +1,202 @@
+package usecases
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// unknownKey represents a mapping key that doesn't match any field of the target type.
+type unknownKey struct {
+	Node *yaml.Node
+	// Known keys at the same level
+	Known []string
+}
+
+// Suggestion returns the known key closest to the unknown one, or "" if none is close enough.
+func (k unknownKey) Suggestion() string {
+	best, bestDistance := "", 0
+	for _, known := range k.Known {
+		distance := levenshtein(strings.ToLower(k.Node.Value), strings.ToLower(known))
+		if best == "" || distance < bestDistance {
+			best, bestDistance = known, distance
+		}
+	}
+	if best == "" || bestDistance > max(2, len(best)/3) {
+		return ""
+	}
+	return best
+}
+
+// Message returns a description of the unknown key with the closest valid key, e.g.
+// "unknown field 'quadarnt' in technology 'Go' (did you mean 'quadrant'?)".
+func (k unknownKey) Message(context string) string {
+	message := fmt.Sprintf("unknown field '%s' in %s", k.Node.Value, context)
+	if suggestion := k.Suggestion(); suggestion != "" {
+		message += fmt.Sprintf(" (did you mean '%s'?)", suggestion)
+	}
+	return message
+}
+
+// unknownFieldPattern matches the errors of yaml.v3 strict decoding for unknown keys.
+var unknownFieldPattern = regexp.MustCompile(`^line \d+: field (.+) not found in type `)
+
+// unknownFields returns the keys of the node tree that yaml.v3 strict decoding
+// (Decoder.KnownFields) rejects for a value of the given type, in document order.
+// The decoder decides which keys are unknown; the node tree only locates them and
+// findUnknownKeys only provides the known keys for "did you mean" hints.
+func unknownFields(node *yaml.Node, t reflect.Type) ([]unknownKey, error) {
+	if node == nil || node.Kind == 0 {
+		return nil, nil
+	}
+	// The node tree is encoded again, so files of all formats are checked the same way
+	data, err := yaml.Marshal(node)
+	if err != nil {
+		return nil, err
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	var typeErr *yaml.TypeError
+	if err := decoder.Decode(reflect.New(t).Interface()); !errors.As(err, &typeErr) {
+		return nil, nil // other decoding errors are reported by the regular decoding
+	}
+
+	hints := make(map[*yaml.Node][]string)
+	for _, key := range findUnknownKeys(node, t) {
+		hints[key.Node] = key.Known
+	}
+	keyNodes := mappingKeys(node)
+
+	var keys []unknownKey
+	next := 0
+	for _, message := range typeErr.Errors {
+		m := unknownFieldPattern.FindStringSubmatch(message)
+		if m == nil {
+			continue
+		}
+		for next < len(keyNodes) && keyNodes[next].Value != m[1] {
+			next++
+		}
+		if next == len(keyNodes) {
+			return keys, fmt.Errorf("unknown field '%s' not found in the document", m[1])
+		}
+		keys = append(keys, unknownKey{Node: keyNodes[next], Known: hints[keyNodes[next]]})
+		next++
+	}
+	return keys, nil
+}
+
+// mappingKeys returns the key nodes of all mappings in the node tree in document order.
+func mappingKeys(node *yaml.Node) []*yaml.Node {
+	var keys []*yaml.Node
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			keys = append(keys, node.Content[i])
+			keys = append(keys, mappingKeys(node.Content[i+1])...)
+		}
+		return keys
+	}
+	for _, child := range node.Content {
+		keys = append(keys, mappingKeys(child)...)
+	}
+	return keys
+}
+
+// findUnknownKeys returns mapping keys of the node tree that don't match the YAML fields
+// of the given type, checking nested structs, slices and maps recursively.
+// It only provides hints for the keys rejected by unknownFields.
+func findUnknownKeys(node *yaml.Node, t reflect.Type) []unknownKey {
+	var keys []unknownKey
+	if node == nil {
+		return keys
+	}
+	if node.Kind == yaml.DocumentNode {
+		return findUnknownKeys(documentRoot(node), t)
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		if node.Kind != yaml.MappingNode {
+			return keys
+		}
+		fields := yamlFields(t)
+		known := make([]string, 0, len(fields))
+		for name := range fields {
+			known = append(known, name)
+		}
+		sort.Strings(known)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			fieldType, ok := fields[key.Value]
+			if !ok {
+				keys = append(keys, unknownKey{Node: key, Known: known})
+				continue
+			}
+			keys = append(keys, findUnknownKeys(value, fieldType)...)
+		}
+	case reflect.Slice, reflect.Array:
+		if node.Kind != yaml.SequenceNode {
+			return keys
+		}
+		for _, item := range node.Content {
+			keys = append(keys, findUnknownKeys(item, t.Elem())...)
+		}
+	case reflect.Map:
+		if node.Kind != yaml.MappingNode {
+			return keys
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			keys = append(keys, findUnknownKeys(node.Content[i+1], t.Elem())...)
+		}
+	}
+
+	return keys
+}
+
+// yamlFields returns the YAML keys of a struct type and the types of their fields.
+func yamlFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < t.NumField(); i++ {
+		if name, ok := yamlKey(t.Field(i)); ok {
+			fields[name] = t.Field(i).Type
+		}
+	}
+	return fields
+}
+
+// yamlFieldNames returns the YAML keys of a struct type in field order.
+func yamlFieldNames(t reflect.Type) []string {
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		if name, ok := yamlKey(t.Field(i)); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// yamlKey returns the YAML key of a struct field following the rules of yaml.v3:
+// the key is taken from the yaml tag or is the lowercased field name,
+// unexported and "-" fields are skipped.
+func yamlKey(field reflect.StructField) (string, bool) {
+	if !field.IsExported() {
+		return "", false
+	}
+	name := strings.Split(field.Tag.Get("yaml"), ",")[0]
+	if name == "-" {
+		return "", false
+	}
+	if name == "" {
+		name = strings.ToLower(field.Name)
+	}
+	return name, true
+}
@@ -0,0 +1,191 @@
+package usecases
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+func TestFindUnknownKeys(t *testing.T) {
+	content := `title: "Radar"
+descripton: "Typo"
+rings:
+  - name: "Adopt"
+    alais: "adopt"
+lint:
+  rules:
+    ring-jump:
+      maxStep: 2
+`
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		t.Fatalf("Failed to parse YAML: %v", err)
+	}
+
+	keys := findUnknownKeys(&doc, reflect.TypeOf(core.MetaFile{}))
+	expected := []struct {
+		key, suggestion string
+		line            int
+	}{
+		{"descripton", "description", 2},
+		{"alais", "alias", 5},
+		{"maxStep", "maxSteps", 9},
+	}
+	if len(keys) != len(expected) {
+		t.Fatalf("Expected %d unknown keys, got %d", len(expected), len(keys))
+	}
+	for i, e := range expected {
+		if keys[i].Node.Value != e.key || keys[i].Suggestion() != e.suggestion || keys[i].Node.Line != e.line {
+			t.Errorf("Expected %s -> %s at line %d, got %s -> %s at line %d", e.key, e.suggestion, e.line,
+				keys[i].Node.Value, keys[i].Suggestion(), keys[i].Node.Line)
+		}
+	}
+}
+
+func TestUnknownFields(t *testing.T) {
+	type base struct {
+		Name string `yaml:"name"`
+	}
+	type entry struct {
+		base `yaml:",inline"`
+		Ring string `yaml:"ring"`
+	}
+	type file struct {
+		Entries []entry `yaml:"entries"`
+	}
+
+	content := `entries:
+  - name: "Go"
+    rign: "Adopt"
+  - name: "Rust"
+    ring: "Assess"
+    owner: "Platform"
+`
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		t.Fatalf("Failed to parse YAML: %v", err)
+	}
+
+	// Keys of inlined structs are known to the decoder
+	keys, err := unknownFields(&doc, reflect.TypeOf(file{}))
+	if err != nil {
+		t.Fatalf("unknownFields failed: %v", err)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("Expected 2 unknown keys, got %d", len(keys))
+	}
+	if keys[0].Node.Value != "rign" || keys[0].Node.Line != 3 || keys[0].Suggestion() != "ring" {
+		t.Errorf("Unexpected first key: %s at line %d (%s)", keys[0].Node.Value, keys[0].Node.Line, keys[0].Suggestion())
+	}
+	if keys[1].Node.Value != "owner" || keys[1].Node.Line != 6 {
+		t.Errorf("Unexpected second key: %s at line %d", keys[1].Node.Value, keys[1].Node.Line)
+	}
+}
+
+func TestUnknownKeyMessage(t *testing.T) {
+	key := unknownKey{Node: &yaml.Node{Value: "quadarnt"}, Known: []string{"name", "quadrant", "ring"}}
+	if got := key.Message("technology 'Go'"); got != "unknown field 'quadarnt' in technology 'Go' (did you mean 'quadrant'?)" {
+		t.Errorf("Unexpected message: %s", got)
+	}
+
+	key = unknownKey{Node: &yaml.Node{Value: "owner"}, Known: []string{"name", "quadrant", "ring"}}
+	if got := key.Message("technology 'Go'"); got != "unknown field 'owner' in technology 'Go'" {
+		t.Errorf("Unexpected message without suggestion: %s", got)
+	}
+}
+
+func TestUnknownKeysInTechnologiesFile(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "20240101.yaml")
+	content := `technologies:
+  - name: "Go"
+    ring: "Adopt"
+    quadarnt: "Languages"
+    description: "Programming language"
+`
+	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	meta := core.DefaultMeta()
+	_, err := readTechnologiesFile(filePath, meta, false)
+	if err == nil || !strings.Contains(err.Error(), "20240101.yaml:4:5: unknown field 'quadarnt' in technology 'Go' (did you mean 'quadrant'?)") {
+		t.Errorf("Expected unknown field error, got %v", err)
+	}
+
+	errs := CollectValidationErrors(filePath, meta, false)
+	if len(errs) != 2 || errs[0].Rule != core.RuleUnknownField || errs[1].Field != "quadrant" {
+		t.Errorf("Expected unknown field and missing quadrant errors, got %v", errs)
+	}
+
+	// Lenient mode keeps the old behaviour: unknown keys are ignored
+	errs = CollectValidationErrors(filePath, meta, true)
+	if len(errs) != 1 || errs[0].Rule != core.RuleMissingField {
+		t.Errorf("Expected only missing quadrant error, got %v", errs)
+	}
+}
+
+func TestUnknownFileKeys(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"date", "unknown field 'date' in file"},
+		{"titel", "unknown field 'titel' in file (did you mean 'title'?)"},
+		{"sumary", "unknown field 'sumary' in file (did you mean 'summary'?)"},
+		{"publishedat", "unknown field 'publishedat' in file (did you mean 'publishedAt'?)"},
+		{"drafts", "unknown field 'drafts' in file (did you mean 'draft'?)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), "20240101.yaml")
+			content := tt.key + `: foo
+technologies:
+  - name: "Go"
+    ring: "Adopt"
+    quadrant: "Languages"
+    description: "Programming language"
+`
+			if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+				t.Fatalf("Failed to create test file: %v", err)
+			}
+
+			errs := CollectValidationErrors(filePath, core.DefaultMeta(), false)
+			if len(errs) != 1 || errs[0].Rule != core.RuleUnknownField || !strings.Contains(errs[0].Message, tt.want) {
+				t.Errorf("Expected %q, got %v", tt.want, errs)
+			}
+		})
+	}
+}
+
+func TestReadMetaUnknownKeys(t *testing.T) {
+	metaPath := filepath.Join(t.TempDir(), "meta.yaml")
+	content := `title: "Radar"
+rings:
+  - name: "Adopt"
+    alias: "adopt"
+    maxAg: "2"
+`
+	if err := os.WriteFile(metaPath, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write meta file: %v", err)
+	}
+
+	_, err := ReadMeta(metaPath, "", false, false)
+	if err == nil || !strings.Contains(err.Error(), "meta.yaml:5:5: unknown field 'maxAg' in meta (did you mean 'maxAge'?)") {
+		t.Errorf("Expected unknown key error, got %v", err)
+	}
+
+	meta, err := ReadMeta(metaPath, "", false, true)
+	if err != nil {
+		t.Fatalf("Expected no error in lenient mode, got %v", err)
+	}
+	if meta.Title != "Radar" {
+		t.Errorf("Expected meta from file, got %+v", meta)
+	}
+}
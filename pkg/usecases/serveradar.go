@@ -0,0 +1,137 @@
+package usecases
+
+import (
+	"fmt"
+	"io/fs"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// ServeRadar represents the preview of a radar over HTTP, drafts included.
+type ServeRadar struct {
+	InputDir     string
+	MetaPath     string
+	TemplatePath string
+	// OutputDir is the directory the radar is generated into before being served
+	OutputDir string
+	Lenient   bool
+	Verbose   bool
+
+	mu sync.Mutex
+	// State of the input files of the last successful generation
+	inputState string
+	latest     string
+}
+
+// Handler returns a handler that serves the generated radar. Pages are regenerated
+// when the input files have changed since the last generation, so edits are visible
+// on reload; other files (assets) are served as generated.
+// Drafts are always included. The root redirects to the latest snapshot.
+func (s *ServeRadar) Handler() http.Handler {
+	files := http.FileServer(http.Dir(s.OutputDir))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" && !strings.HasSuffix(r.URL.Path, ".html") {
+			files.ServeHTTP(w, r)
+			return
+		}
+		latest, err := s.generate()
+		if err != nil {
+			log.Printf("Failed to generate radar: %v", err)
+			http.Error(w, fmt.Sprintf("Failed to generate radar: %v", err), http.StatusInternalServerError)
+			return
+		}
+		if r.URL.Path == "/" {
+			if latest == "" {
+				http.Error(w, "No snapshots found in "+s.InputDir, http.StatusNotFound)
+				return
+			}
+			http.Redirect(w, r, "/"+latest+".html", http.StatusFound)
+			return
+		}
+		files.ServeHTTP(w, r)
+	})
+}
+
+// generate reads the radar and regenerates all its pages if the input files have changed.
+// Returns the date of the latest snapshot, empty if there are no snapshots.
+func (s *ServeRadar) generate() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, err := s.readInputState()
+	if err != nil {
+		return "", err
+	}
+	if state == s.inputState {
+		return s.latest, nil
+	}
+
+	meta, err := ReadMeta(s.MetaPath, s.InputDir, false, s.Lenient)
+	if err != nil {
+		return "", err
+	}
+	files, err := ReadTechnologiesFiles(s.InputDir, meta, ReadOptions{Lenient: s.Lenient, IncludeDrafts: true})
+	if err != nil {
+		return "", err
+	}
+
+	generator := GenerateRadar{
+		OutputDir:             s.OutputDir,
+		TemplatePath:          s.TemplatePath,
+		Files:                 files,
+		Meta:                  meta,
+		Force:                 true,
+		Verbose:               s.Verbose,
+		AddChanges:            true,
+		SkipFirstRadarChanges: true,
+	}
+	if err := generator.Do(); err != nil {
+		return "", err
+	}
+
+	s.latest = ""
+	if len(files) > 0 {
+		s.latest = files[len(files)-1].Date
+	}
+	s.inputState = state
+	return s.latest, nil
+}
+
+// readInputState returns the paths, sizes and modification times of the input directory,
+// the meta file and the template, which change whenever one of the input files changes.
+func (s *ServeRadar) readInputState() (string, error) {
+	var state strings.Builder
+	add := func(path string, info fs.FileInfo) {
+		fmt.Fprintf(&state, "%s %d %d\n", path, info.Size(), info.ModTime().UnixNano())
+	}
+
+	err := filepath.WalkDir(s.InputDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		add(path, info)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for _, path := range []string{s.MetaPath, s.TemplatePath} {
+		if path == "" {
+			continue
+		}
+		// Missing files are reported by the generation
+		if info, err := os.Stat(path); err == nil {
+			add(path, info)
+		}
+	}
+	return state.String(), nil
+}
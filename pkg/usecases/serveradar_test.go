@@ -0,0 +1,75 @@
+package usecases
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestServeRadar(t *testing.T) {
+	inputDir := t.TempDir()
+	tech := "technologies:\n  - name: Go\n    ring: Adopt\n    quadrant: Languages\n"
+	if err := os.WriteFile(filepath.Join(inputDir, "20240101.yaml"), []byte(tech), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(inputDir, "20240401.draft.yaml"), []byte(tech), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	server := &ServeRadar{InputDir: inputDir, OutputDir: t.TempDir()}
+	handler := server.Handler()
+
+	t.Run("root redirects to the latest snapshot with drafts", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		if rec.Code != http.StatusFound || rec.Header().Get("Location") != "/20240401.html" {
+			t.Errorf("Expected redirect to the draft, got %d %s", rec.Code, rec.Header().Get("Location"))
+		}
+	})
+
+	t.Run("draft is served", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/20240401.html", nil))
+		if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "Draft, not published yet") {
+			t.Errorf("Expected the draft page, got %d", rec.Code)
+		}
+	})
+
+	t.Run("edits are visible on reload", func(t *testing.T) {
+		edited := "title: Edited draft\n" + tech
+		if err := os.WriteFile(filepath.Join(inputDir, "20240401.draft.yaml"), []byte(edited), 0644); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/20240401.html", nil))
+		if !strings.Contains(rec.Body.String(), "Edited draft") {
+			t.Error("Expected the edited draft to be served")
+		}
+	})
+
+	t.Run("unchanged input is not regenerated", func(t *testing.T) {
+		page := filepath.Join(server.OutputDir, "20240401.html")
+		if err := os.WriteFile(page, []byte("cached"), 0644); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/20240401.html", nil))
+		if rec.Body.String() != "cached" {
+			t.Error("Expected the page not to be regenerated")
+		}
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		if err := os.WriteFile(filepath.Join(inputDir, "20240401.draft.yaml"), []byte("technologies:\n  - name: Go\n    ring: Never\n"), 0644); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/20240401.html", nil))
+		if rec.Code != http.StatusInternalServerError {
+			t.Errorf("Expected status 500 for an invalid file, got %d", rec.Code)
+		}
+	})
+}
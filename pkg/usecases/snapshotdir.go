@@ -0,0 +1,250 @@
+package usecases
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+// technologyMarkdownExt is the extension of technology files in snapshot directories.
+const technologyMarkdownExt = ".md"
+
+// frontMatterDelimiter starts and ends the front matter of a technology Markdown file.
+const frontMatterDelimiter = "---"
+
+// technologyFrontMatter is the front matter of a technology Markdown file.
+// The quadrant is the name of the directory and the description is the Markdown body.
+type technologyFrontMatter struct {
+	// Name defaults to the file name without extension
+	Name       string   `yaml:"name,omitempty"`
+	Ring       string   `yaml:"ring"`
+	Info       string   `yaml:"info,omitempty"`
+	ReviewedAt string   `yaml:"reviewedAt,omitempty"`
+	ReplacedBy string   `yaml:"replacedBy,omitempty"`
+	Tags       []string `yaml:"tags,omitempty"`
+}
+
+// snapshotIndexFile is the optional file with the title and summary of a snapshot directory.
+const snapshotIndexFile = "index.md"
+
+// snapshotFrontMatter is the front matter of the index file of a snapshot directory,
+// the Markdown body is the summary.
+type snapshotFrontMatter struct {
+	Title       string `yaml:"title,omitempty"`
+	PublishedAt string `yaml:"publishedAt,omitempty"`
+	Draft       bool   `yaml:"draft,omitempty"`
+}
+
+// isSnapshotDir checks if the directory is a snapshot in the directory layout:
+// its name with a .yaml extension matches the file name pattern from meta.
+func isSnapshotDir(name string, datePattern *regexp.Regexp) bool {
+	return datePattern.MatchString(name + ".yaml")
+}
+
+// parseTechnologiesDir reads a snapshot directory with one Markdown file per technology:
+//
+//	YYYYMMDD/<quadrant>/<technology>.md
+//
+// The name of a quadrant directory is the quadrant name or alias from meta.
+// The title, summary and draft flag of the snapshot are read from index.md in the snapshot directory,
+// other files directly in the snapshot directory and deeper directories are ignored.
+// Technologies are ordered by quadrant directory and file name.
+func parseTechnologiesDir(dirPath string) (core.TechnologiesFile, core.ValidationErrors, error) {
+	var technologiesFile core.TechnologiesFile
+	var schemaErrs core.ValidationErrors
+
+	quadrants, err := os.ReadDir(dirPath)
+	if err != nil {
+		return technologiesFile, nil, fmt.Errorf("error reading directory: %v", err)
+	}
+
+	for _, quadrant := range quadrants {
+		if !quadrant.IsDir() {
+			if quadrant.Name() == snapshotIndexFile {
+				errs, err := parseSnapshotIndex(filepath.Join(dirPath, quadrant.Name()), &technologiesFile)
+				if err != nil {
+					return technologiesFile, nil, err
+				}
+				schemaErrs = append(schemaErrs, errs...)
+			}
+			continue
+		}
+		quadrantPath := filepath.Join(dirPath, quadrant.Name())
+		entries, err := os.ReadDir(quadrantPath)
+		if err != nil {
+			return technologiesFile, nil, fmt.Errorf("error reading directory: %v", err)
+		}
+
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.EqualFold(filepath.Ext(entry.Name()), technologyMarkdownExt) {
+				continue
+			}
+			filePath := filepath.Join(quadrantPath, entry.Name())
+			tech, errs, err := parseTechnologyMarkdown(filePath, quadrant.Name())
+			if err != nil {
+				return technologiesFile, nil, err
+			}
+			technologiesFile.Technologies = append(technologiesFile.Technologies, tech)
+			schemaErrs = append(schemaErrs, errs...)
+		}
+	}
+
+	return technologiesFile, schemaErrs, nil
+}
+
+// parseSnapshotIndex reads the title, publication date, draft flag and summary of a snapshot directory.
+// Unknown front matter keys are returned as schema errors.
+func parseSnapshotIndex(filePath string, technologiesFile *core.TechnologiesFile) (core.ValidationErrors, error) {
+	var schemaErrs core.ValidationErrors
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading file: %v", err)
+	}
+	frontMatter, body, _, err := splitFrontMatter(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", filePath, err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(frontMatter, &doc); err != nil {
+		return nil, fmt.Errorf("%s: error parsing front matter: %v", filePath, err)
+	}
+	var fm snapshotFrontMatter
+	if doc.Kind != 0 {
+		if err := doc.Decode(&fm); err != nil {
+			return nil, fmt.Errorf("%s: error decoding front matter: %v", filePath, err)
+		}
+	}
+
+	technologiesFile.Title = fm.Title
+	technologiesFile.PublishedAt = fm.PublishedAt
+	technologiesFile.Draft = fm.Draft
+	technologiesFile.Summary = strings.TrimSpace(string(body))
+
+	unknown, err := unknownFields(&doc, reflect.TypeOf(fm))
+	if err != nil {
+		return nil, fmt.Errorf("%s: error decoding front matter: %v", filePath, err)
+	}
+	for _, key := range unknown {
+		schemaErrs = append(schemaErrs, schemaError(filePath, "", key, "snapshot front matter"))
+	}
+	return schemaErrs, nil
+}
+
+// parseTechnologyMarkdown reads a technology from a Markdown file with YAML front matter.
+// Unknown front matter keys are returned as schema errors.
+func parseTechnologyMarkdown(filePath, quadrant string) (core.Technology, core.ValidationErrors, error) {
+	name := strings.TrimSuffix(filepath.Base(filePath), filepath.Ext(filePath))
+	tech := core.Technology{
+		Name:     name,
+		Quadrant: quadrant,
+		Positions: core.TechnologyPositions{
+			Entry: core.Position{File: filePath, Line: 1, Column: 1},
+		},
+	}
+	var schemaErrs core.ValidationErrors
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return tech, nil, fmt.Errorf("error reading file: %v", err)
+	}
+
+	frontMatter, body, bodyLine, err := splitFrontMatter(data)
+	if err != nil {
+		return tech, nil, fmt.Errorf("%s: %v", filePath, err)
+	}
+
+	// The front matter starts with "---", which is a YAML document start,
+	// so line numbers of the parsed nodes are the line numbers in the file
+	var doc yaml.Node
+	if err := yaml.Unmarshal(frontMatter, &doc); err != nil {
+		return tech, nil, fmt.Errorf("%s: error parsing front matter: %v", filePath, err)
+	}
+
+	var fm technologyFrontMatter
+	if doc.Kind != 0 {
+		if err := doc.Decode(&fm); err != nil {
+			return tech, nil, fmt.Errorf("%s: error decoding front matter: %v", filePath, err)
+		}
+	}
+
+	if fm.Name != "" {
+		tech.Name = fm.Name
+	}
+	tech.Ring = fm.Ring
+	tech.Info = fm.Info
+	tech.ReviewedAt = fm.ReviewedAt
+	tech.ReplacedBy = fm.ReplacedBy
+	tech.Tags = fm.Tags
+	tech.Description = strings.TrimSpace(string(body))
+	if tech.Description != "" {
+		html, err := renderMarkdown(tech.Description)
+		if err != nil {
+			return tech, nil, fmt.Errorf("%s: error rendering description: %v", filePath, err)
+		}
+		tech.DescriptionHTML = html
+	}
+
+	root := documentRoot(&doc)
+	fields := map[string]*core.Position{
+		"name": &tech.Positions.Name,
+		"ring": &tech.Positions.Ring,
+	}
+	for key, pos := range fields {
+		if value := mappingValue(root, key); value != nil {
+			*pos = core.Position{File: filePath, Line: value.Line, Column: value.Column}
+		}
+	}
+	if tech.Description != "" {
+		tech.Positions.Description = core.Position{File: filePath, Line: bodyLine, Column: 1}
+	}
+
+	context := fmt.Sprintf("front matter of technology '%s'", tech.Name)
+	unknown, err := unknownFields(root, reflect.TypeOf(fm))
+	if err != nil {
+		return tech, schemaErrs, fmt.Errorf("%s: error decoding front matter: %v", filePath, err)
+	}
+	for _, key := range unknown {
+		schemaErrs = append(schemaErrs, schemaError(filePath, tech.Name, key, context))
+	}
+
+	return tech, schemaErrs, nil
+}
+
+// splitFrontMatter splits Markdown content into the front matter (including the opening
+// delimiter) and the body, and returns the line number the body starts at.
+// Content without front matter is all body.
+func splitFrontMatter(data []byte) ([]byte, []byte, int, error) {
+	lines := bytes.SplitAfter(data, []byte("\n"))
+	if len(lines) == 0 || strings.TrimSpace(string(lines[0])) != frontMatterDelimiter {
+		return nil, data, 1, nil
+	}
+
+	offset := len(lines[0])
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(string(lines[i])) == frontMatterDelimiter {
+			body := data[offset+len(lines[i]):]
+			// Skip blank lines before the body
+			bodyLine := i + 2
+			for _, line := range lines[i+1:] {
+				if strings.TrimSpace(string(line)) != "" {
+					break
+				}
+				bodyLine++
+			}
+			return data[:offset], body, bodyLine, nil
+		}
+		offset += len(lines[i])
+	}
+
+	return nil, nil, 0, fmt.Errorf("front matter is not closed with '%s'", frontMatterDelimiter)
+}
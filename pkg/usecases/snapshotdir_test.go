@@ -0,0 +1,146 @@
+package usecases
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+// writeSnapshotDir creates technology Markdown files in a snapshot directory.
+func writeSnapshotDir(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+	}
+}
+
+func TestReadTechnologiesFilesDirectoryLayout(t *testing.T) {
+	meta := core.DefaultMeta()
+	inputDir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(inputDir, "20240101.yaml"), []byte(`technologies:
+  - name: "Go"
+    ring: "Trial"
+    quadrant: "Languages"
+    description: "Programming language"
+  - name: "Docker"
+    ring: "Adopt"
+    quadrant: "Platforms"
+    description: "Containers"
+`), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	writeSnapshotDir(t, filepath.Join(inputDir, "20240201"), map[string]string{
+		"Languages/Go.md":     "---\nring: Adopt\ntags: [backend]\n---\n\nProgramming language\n\nwith **goroutines**.\n",
+		"Languages/cpp.md":    "---\nname: C++\nring: Hold\nreplacedBy: Go\n---\nOld language\n",
+		"README.md":           "Not a technology",
+		"index.md":            "---\ntitle: Consolidation\npublishedAt: 2024-02-05\n---\n\nWe moved to **one** platform.\n",
+		"Languages/notes.txt": "Not a technology",
+	})
+
+	files, err := ReadTechnologiesFiles(inputDir, meta, ReadOptions{})
+	if err != nil {
+		t.Fatalf("ReadTechnologiesFiles failed: %v", err)
+	}
+	if len(files) != 2 || files[1].Date != "20240201" {
+		t.Fatalf("Expected 2 snapshots, got %+v", files)
+	}
+	if files[1].Title != "Consolidation" || files[1].PublishedAt != "2024-02-05" || files[1].Summary != "We moved to **one** platform." {
+		t.Errorf("Unexpected snapshot info: %q, %q, %q", files[1].Title, files[1].PublishedAt, files[1].Summary)
+	}
+
+	for i := range files[1].Technologies {
+		files[1].Technologies[i].Positions = core.TechnologyPositions{}
+	}
+	expected := []core.Technology{
+		{Name: "Go", Ring: "Adopt", Quadrant: "Languages", Description: "Programming language\n\nwith **goroutines**.",
+			DescriptionHTML: "<p>Programming language</p>\n<p>with <strong>goroutines</strong>.</p>\n",
+			Tags:            []string{"backend"}, IsMoved: true, PreviousRing: "Trial"},
+		{Name: "C++", Ring: "Hold", Quadrant: "Languages", Description: "Old language",
+			DescriptionHTML: "<p>Old language</p>\n", ReplacedBy: "Go", IsNew: true},
+		{Name: "Docker", Ring: "Adopt", Quadrant: "Platforms", Description: "Containers", IsDeleted: true},
+	}
+	if !reflect.DeepEqual(files[1].Technologies, expected) {
+		t.Errorf("Unexpected technologies:\n%+v\nexpected:\n%+v", files[1].Technologies, expected)
+	}
+}
+
+func TestCollectValidationErrorsDirectoryLayout(t *testing.T) {
+	meta := core.DefaultMeta()
+	dir := filepath.Join(t.TempDir(), "20240101")
+	writeSnapshotDir(t, dir, map[string]string{
+		"Languages/Go.md":   "---\nring: Adopt\nrign: Adopt\n---\nProgramming language\n",
+		"Languages/Rust.md": "---\nring: Sometimes\n---\nSystems language\n",
+		"Languages/Zig.md":  "---\nring: Assess\n---\n",
+		"Unknown/Elm.md":    "---\nring: Assess\n---\nFunctional language\n",
+	})
+
+	errs := CollectValidationErrors(dir, meta, false)
+	expected := []struct {
+		file string
+		rule string
+		line int
+	}{
+		{"Languages/Go.md", core.RuleUnknownField, 3},
+		{"Languages/Rust.md", core.RuleInvalidRing, 2},
+		{"Languages/Zig.md", core.RuleMissingField, 1},
+		{"Unknown/Elm.md", core.RuleInvalidQuadrant, 1},
+	}
+	if len(errs) != len(expected) {
+		t.Fatalf("Expected %d errors, got %v", len(expected), errs)
+	}
+	for i, e := range expected {
+		if errs[i].File != filepath.Join(dir, e.file) || errs[i].Rule != e.rule || errs[i].Line != e.line {
+			t.Errorf("Unexpected error #%d: %+v", i, errs[i])
+		}
+	}
+
+	writeSnapshotDir(t, dir, map[string]string{"Languages/Go.md": "---\nring: Adopt\n"})
+	errs = CollectValidationErrors(dir, meta, false)
+	if len(errs) != 1 || errs[0].Rule != core.RuleSyntax || !strings.Contains(errs[0].Message, "front matter is not closed") {
+		t.Errorf("Expected syntax error for unclosed front matter, got %v", errs)
+	}
+}
+
+func TestCreateSnapshotDirectoryLayout(t *testing.T) {
+	inputDir := t.TempDir()
+	writeSnapshotDir(t, filepath.Join(inputDir, "20240101"), map[string]string{
+		"Languages/Go.md":   "---\nring: Adopt\n---\nProgramming language\n",
+		"Languages/Rust.md": "---\nring: Assess\n---\nSystems language\n",
+	})
+
+	rings := []core.Ring{
+		{Name: "Adopt", Alias: "adopt", Persist: true},
+		{Name: "Assess", Alias: "assess"},
+	}
+	meta := core.NewMeta("", "", nil, rings)
+
+	snapshot := CreateSnapshot{InputDir: inputDir, Meta: meta, Date: "20240401", PersistentOnly: true}
+	newDir, err := snapshot.Do()
+	if err != nil {
+		t.Fatalf("CreateSnapshot failed: %v", err)
+	}
+	if newDir != filepath.Join(inputDir, "20240401") {
+		t.Errorf("Unexpected snapshot path %s", newDir)
+	}
+	if _, err := os.Stat(filepath.Join(newDir, "Languages", "Go.md")); err != nil {
+		t.Errorf("Expected Go to be carried over: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(newDir, "Languages", "Rust.md")); !os.IsNotExist(err) {
+		t.Errorf("Expected Rust not to be carried over, got %v", err)
+	}
+
+	if _, err := snapshot.Do(); err == nil {
+		t.Error("Expected error for existing snapshot, got nil")
+	}
+}
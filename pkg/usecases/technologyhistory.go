@@ -0,0 +1,221 @@
+package usecases
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+const (
+	// HistoryStatusNew indicates the first appearance of a technology
+	HistoryStatusNew = "new"
+	// HistoryStatusUnchanged indicates no change since the previous snapshot
+	HistoryStatusUnchanged = "unchanged"
+	// HistoryStatusMoved indicates a ring change since the previous snapshot
+	HistoryStatusMoved = "moved"
+	// HistoryStatusDeleted indicates the technology was removed in this snapshot
+	HistoryStatusDeleted = "deleted"
+	// HistoryStatusReAdded indicates the technology came back after being deleted
+	HistoryStatusReAdded = "re-added"
+)
+
+// HistoryEntry represents the state of a technology in a single snapshot.
+type HistoryEntry struct {
+	Date         string `json:"date"`
+	Ring         string `json:"ring"`
+	Quadrant     string `json:"quadrant"`
+	Status       string `json:"status"`
+	PreviousRing string `json:"previousRing,omitempty"`
+	// Time of the snapshot (zero if unknown)
+	Time time.Time `json:"-"`
+}
+
+// RingPeriod represents a continuous stay of a technology in a ring.
+// To is the date of the snapshot where the technology left the ring,
+// or the date of the latest snapshot if Current is true.
+type RingPeriod struct {
+	Ring    string `json:"ring"`
+	From    string `json:"from"`
+	To      string `json:"to"`
+	Periods int    `json:"periods"`
+	Days    int    `json:"days,omitempty"`
+	Current bool   `json:"current"`
+	// Times of the From and To snapshots (zero if unknown)
+	FromTime time.Time `json:"-"`
+	ToTime   time.Time `json:"-"`
+}
+
+// TechnologyHistory represents the timeline of a technology across snapshots.
+type TechnologyHistory struct {
+	Name    string         `json:"name"`
+	Entries []HistoryEntry `json:"entries"`
+	Rings   []RingPeriod   `json:"rings"`
+}
+
+// GetTechnologyHistory builds the timeline of a technology from snapshots sorted by date.
+// The name is matched case-insensitively. Snapshots where the technology is absent
+// are skipped, except the one where it was deleted.
+func GetTechnologyHistory(files []core.TechnologiesFile, name string) TechnologyHistory {
+	builder := historyBuilder{history: TechnologyHistory{Name: name}}
+	for _, file := range files {
+		builder.add(file, findTechnology(file.Technologies, name))
+	}
+	return builder.build(files)
+}
+
+// technologyHistories builds the timelines of all technologies of the snapshots in one pass,
+// in order of first appearance. Names are matched case-insensitively, as in GetTechnologyHistory.
+func technologyHistories(files []core.TechnologiesFile) []TechnologyHistory {
+	var builders []*historyBuilder
+	byKey := make(map[string]*historyBuilder)
+	// Technologies present in the previous snapshot, which may be deleted in the next one
+	var active []*historyBuilder
+
+	for _, file := range files {
+		present := make(map[*historyBuilder]*core.Technology)
+		var order []*historyBuilder
+		for i := range file.Technologies {
+			tech := &file.Technologies[i]
+			if tech.IsDeleted {
+				continue
+			}
+			key := strings.ToLower(strings.TrimSpace(tech.Name))
+			builder, ok := byKey[key]
+			if !ok {
+				builder = &historyBuilder{history: TechnologyHistory{Name: tech.Name}}
+				byKey[key] = builder
+				builders = append(builders, builder)
+			}
+			// The first entry is used for a technology listed twice, as in findTechnology
+			if _, ok := present[builder]; !ok {
+				present[builder] = tech
+				order = append(order, builder)
+			}
+		}
+
+		for _, builder := range active {
+			if present[builder] == nil {
+				builder.add(file, nil)
+			}
+		}
+		for _, builder := range order {
+			builder.add(file, present[builder])
+		}
+		active = order
+	}
+
+	histories := make([]TechnologyHistory, 0, len(builders))
+	for _, builder := range builders {
+		histories = append(histories, builder.build(files))
+	}
+	return histories
+}
+
+// historyBuilder collects the history entries of a technology snapshot by snapshot.
+type historyBuilder struct {
+	history  TechnologyHistory
+	previous *core.Technology
+	seen     bool
+}
+
+// add adds the state of the technology in the next snapshot, nil if it is absent.
+func (b *historyBuilder) add(file core.TechnologiesFile, current *core.Technology) {
+	previous := b.previous
+	b.previous = current
+
+	switch {
+	case current == nil && previous != nil:
+		b.history.Entries = append(b.history.Entries, HistoryEntry{
+			Date:     file.Date,
+			Time:     file.Time,
+			Ring:     previous.Ring,
+			Quadrant: previous.Quadrant,
+			Status:   HistoryStatusDeleted,
+		})
+	case current != nil:
+		b.history.Name = current.Name
+		entry := HistoryEntry{Date: file.Date, Time: file.Time, Ring: current.Ring, Quadrant: current.Quadrant}
+		switch {
+		case !b.seen:
+			entry.Status = HistoryStatusNew
+		case previous == nil:
+			entry.Status = HistoryStatusReAdded
+		case previous.Ring != current.Ring:
+			entry.Status = HistoryStatusMoved
+			entry.PreviousRing = previous.Ring
+		default:
+			entry.Status = HistoryStatusUnchanged
+		}
+		b.history.Entries = append(b.history.Entries, entry)
+		b.seen = true
+	}
+}
+
+// build returns the history with the ring periods, files are all the snapshots added.
+func (b *historyBuilder) build(files []core.TechnologiesFile) TechnologyHistory {
+	var last core.TechnologiesFile
+	if len(files) > 0 {
+		last = files[len(files)-1]
+	}
+	history := b.history
+	history.Rings = ringPeriods(history.Entries, last)
+	return history
+}
+
+// ringPeriods groups history entries into continuous stays in a ring,
+// last is the latest snapshot.
+func ringPeriods(entries []HistoryEntry, last core.TechnologiesFile) []RingPeriod {
+	var periods []RingPeriod
+	var current *RingPeriod
+
+	closePeriod := func(to string, toTime time.Time) {
+		if current == nil {
+			return
+		}
+		current.To = to
+		current.ToTime = toTime
+		periods = append(periods, *current)
+		current = nil
+	}
+
+	for _, entry := range entries {
+		if entry.Status == HistoryStatusDeleted {
+			closePeriod(entry.Date, entry.Time)
+			continue
+		}
+		if current != nil && current.Ring != entry.Ring {
+			closePeriod(entry.Date, entry.Time)
+		}
+		if current == nil {
+			current = &RingPeriod{Ring: entry.Ring, From: entry.Date, FromTime: entry.Time}
+		}
+		current.Periods++
+	}
+	if current != nil {
+		current.Current = true
+		closePeriod(last.Date, last.Time)
+	}
+
+	for i := range periods {
+		from, okFrom := snapshotTime(periods[i].From, periods[i].FromTime)
+		to, okTo := snapshotTime(periods[i].To, periods[i].ToTime)
+		if okFrom && okTo {
+			periods[i].Days = int(to.Sub(from).Hours() / 24)
+		}
+	}
+
+	return periods
+}
+
+// findTechnology returns the technology with the given name (case-insensitive),
+// ignoring entries marked as deleted.
+func findTechnology(technologies []core.Technology, name string) *core.Technology {
+	for i := range technologies {
+		tech := &technologies[i]
+		if !tech.IsDeleted && strings.EqualFold(strings.TrimSpace(tech.Name), strings.TrimSpace(name)) {
+			return tech
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package usecases
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+func TestGetTechnologyHistory(t *testing.T) {
+	files := []core.TechnologiesFile{
+		{Date: "20240101", Technologies: []core.Technology{
+			{Name: "Kafka", Ring: "Assess", Quadrant: "Platforms"},
+		}},
+		{Date: "20240401", Technologies: []core.Technology{
+			{Name: "Kafka", Ring: "Trial", Quadrant: "Platforms"},
+		}},
+		{Date: "20240701", Technologies: []core.Technology{
+			{Name: "Kafka", Ring: "Trial", Quadrant: "Platforms"},
+		}},
+		{Date: "20241001", Technologies: []core.Technology{
+			{Name: "Go", Ring: "Adopt", Quadrant: "Languages"},
+			// Deleted entries added by markChanges should be ignored
+			{Name: "Kafka", Ring: "Trial", Quadrant: "Platforms", IsDeleted: true},
+		}},
+		{Date: "20250101", Technologies: []core.Technology{
+			{Name: "Go", Ring: "Adopt", Quadrant: "Languages"},
+		}},
+		{Date: "20250401", Technologies: []core.Technology{
+			{Name: "Kafka", Ring: "Adopt", Quadrant: "Platforms"},
+		}},
+	}
+
+	history := GetTechnologyHistory(files, "kafka")
+
+	if history.Name != "Kafka" {
+		t.Errorf("Expected name 'Kafka', got '%s'", history.Name)
+	}
+
+	expectedEntries := []HistoryEntry{
+		{Date: "20240101", Ring: "Assess", Quadrant: "Platforms", Status: HistoryStatusNew},
+		{Date: "20240401", Ring: "Trial", Quadrant: "Platforms", Status: HistoryStatusMoved, PreviousRing: "Assess"},
+		{Date: "20240701", Ring: "Trial", Quadrant: "Platforms", Status: HistoryStatusUnchanged},
+		{Date: "20241001", Ring: "Trial", Quadrant: "Platforms", Status: HistoryStatusDeleted},
+		{Date: "20250401", Ring: "Adopt", Quadrant: "Platforms", Status: HistoryStatusReAdded},
+	}
+	if len(history.Entries) != len(expectedEntries) {
+		t.Fatalf("Expected %d entries, got %d: %+v", len(expectedEntries), len(history.Entries), history.Entries)
+	}
+	for i, entry := range history.Entries {
+		if entry != expectedEntries[i] {
+			t.Errorf("Entry %d: expected %+v, got %+v", i, expectedEntries[i], entry)
+		}
+	}
+
+	expectedRings := []RingPeriod{
+		{Ring: "Assess", From: "20240101", To: "20240401", Periods: 1, Days: 91},
+		{Ring: "Trial", From: "20240401", To: "20241001", Periods: 2, Days: 183},
+		{Ring: "Adopt", From: "20250401", To: "20250401", Periods: 1, Current: true},
+	}
+	if len(history.Rings) != len(expectedRings) {
+		t.Fatalf("Expected %d ring periods, got %d: %+v", len(expectedRings), len(history.Rings), history.Rings)
+	}
+	for i, period := range history.Rings {
+		if period != expectedRings[i] {
+			t.Errorf("Ring period %d: expected %+v, got %+v", i, expectedRings[i], period)
+		}
+	}
+}
+
+func TestTechnologyHistories(t *testing.T) {
+	files := []core.TechnologiesFile{
+		{Date: "20240101", Technologies: []core.Technology{
+			{Name: "Kafka", Ring: "Assess", Quadrant: "Platforms"},
+			{Name: "Go", Ring: "Trial", Quadrant: "Languages"},
+		}},
+		{Date: "20240401", Technologies: []core.Technology{
+			{Name: "go", Ring: "Adopt", Quadrant: "Languages"},
+		}},
+		{Date: "20240701", Technologies: []core.Technology{
+			{Name: "Rust", Ring: "Assess", Quadrant: "Languages"},
+			{Name: "Kafka", Ring: "Trial", Quadrant: "Platforms"},
+			{Name: "Go", Ring: "Adopt", Quadrant: "Languages"},
+		}},
+	}
+
+	histories := technologyHistories(files)
+	if len(histories) != 3 {
+		t.Fatalf("Expected 3 histories, got %+v", histories)
+	}
+	for i, name := range []string{"Kafka", "Go", "Rust"} {
+		if expected := GetTechnologyHistory(files, name); !reflect.DeepEqual(histories[i], expected) {
+			t.Errorf("History of %s:\nexpected %+v\ngot      %+v", name, expected, histories[i])
+		}
+	}
+}
+
+func TestGetTechnologyHistoryNotFound(t *testing.T) {
+	files := []core.TechnologiesFile{
+		{Date: "20240101", Technologies: []core.Technology{
+			{Name: "Go", Ring: "Adopt", Quadrant: "Languages"},
+		}},
+	}
+
+	history := GetTechnologyHistory(files, "Kafka")
+	if len(history.Entries) != 0 || len(history.Rings) != 0 {
+		t.Errorf("Expected empty history, got %+v", history)
+	}
+}
@@ -1,40 +1,296 @@
 package usecases
 
 import (
+	"cmp"
 	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"time"
 
 	"github.com/ekalinin/terago/pkg/core"
 )
 
 // ValidateTechnologiesFile validates a single technologies YAML file.
 // It checks YAML syntax, required fields, and validates rings and quadrants.
+// Only the first problem is returned, see CollectValidationErrors for all of them.
 func ValidateTechnologiesFile(filePath string, meta core.Meta) error {
+	return CollectValidationErrors(filePath, meta, false).First()
+}
+
+// CollectValidationErrors validates a single technologies YAML file and returns
+// all problems found: YAML syntax, empty technologies list, missing required fields,
+// invalid rings and quadrants, and duplicate technologies.
+// Unknown keys are reported unless lenient is set.
+func CollectValidationErrors(filePath string, meta core.Meta, lenient bool) core.ValidationErrors {
+	var errs core.ValidationErrors
+
 	// Read and parse file
-	technologiesFile, err := readTechnologiesFile(filePath, meta)
+	technologiesFile, schemaErrs, err := parseTechnologiesFile(filePath)
 	if err != nil {
-		return err
+		return append(errs, core.ValidationError{
+			File:     filePath,
+			Rule:     core.RuleSyntax,
+			Severity: core.SeverityError,
+			Message:  err.Error(),
+			Line:     yamlErrorLine(err),
+		})
 	}
 
 	// Check if technologies list is empty
 	if len(technologiesFile.Technologies) == 0 {
-		return fmt.Errorf("no technologies found in file")
+		return append(errs, core.ValidationError{
+			File:     filePath,
+			Rule:     core.RuleEmpty,
+			Severity: core.SeverityError,
+			Message:  "no technologies found in file",
+		})
 	}
 
-	// Additional validation: check for required fields
+	// Unknown keys are typos unless lenient is set
+	if !lenient {
+		errs = append(errs, schemaErrs...)
+	}
+
+	if _, err := technologiesFile.PublishedTime(); err != nil {
+		errs = append(errs, core.ValidationError{
+			File:     filePath,
+			Field:    "publishedAt",
+			Rule:     core.RuleInvalidDate,
+			Severity: core.SeverityError,
+			Message:  err.Error(),
+		})
+	}
+
+	// Check required fields, rings and quadrants of each technology
 	for i, tech := range technologiesFile.Technologies {
-		if tech.Name == "" {
-			return fmt.Errorf("technology #%d is missing 'name' field", i+1)
+		missing := func(field string) {
+			message := fmt.Sprintf("technology '%s' is missing '%s' field", tech.Name, field)
+			if tech.Name == "" {
+				message = fmt.Sprintf("technology #%d is missing '%s' field", i+1, field)
+			}
+			pos := tech.FieldPosition(field)
+			errs = append(errs, core.ValidationError{
+				File:       cmp.Or(pos.File, filePath),
+				Technology: tech.Name,
+				Field:      field,
+				Rule:       core.RuleMissingField,
+				Severity:   core.SeverityError,
+				Message:    message,
+				Line:       pos.Line,
+				Column:     pos.Column,
+			})
+		}
+
+		for _, field := range core.RequiredTechnologyFields {
+			if tech.FieldValue(field) == "" {
+				missing(field)
+			}
 		}
-		if tech.Ring == "" {
-			return fmt.Errorf("technology '%s' is missing 'ring' field", tech.Name)
+
+		// Missing ring or quadrant is already reported above
+		for _, e := range tech.RingAndQuadrantErrors(meta) {
+			if e.Field == "ring" && tech.Ring == "" || e.Field == "quadrant" && tech.Quadrant == "" {
+				continue
+			}
+			e.File = cmp.Or(tech.Positions.Entry.File, filePath)
+			errs = append(errs, e)
 		}
-		if tech.Quadrant == "" {
-			return fmt.Errorf("technology '%s' is missing 'quadrant' field", tech.Name)
+
+		if _, ok := parseReviewDate(tech.ReviewedAt); tech.ReviewedAt != "" && !ok {
+			pos := tech.FieldPosition("reviewedAt")
+			errs = append(errs, core.ValidationError{
+				File:       cmp.Or(pos.File, filePath),
+				Technology: tech.Name,
+				Field:      "reviewedAt",
+				Rule:       core.RuleInvalidDate,
+				Severity:   core.SeverityError,
+				Message:    fmt.Sprintf("invalid reviewedAt '%s' in technology '%s': expected YYYY-MM-DD", tech.ReviewedAt, tech.Name),
+				Line:       pos.Line,
+				Column:     pos.Column,
+			})
+		}
+	}
+
+	// Check for technologies listed more than once
+	errs = append(errs, duplicateErrors(filePath, technologiesFile.Technologies)...)
+
+	return errs
+}
+
+// FileValidation represents validation results of a single technologies file.
+type FileValidation struct {
+	File   string                `json:"file"`
+	Errors core.ValidationErrors `json:"errors"`
+}
+
+// ValidationReport represents validation results of all technologies files of a radar.
+type ValidationReport struct {
+	Files []FileValidation `json:"files"`
+}
+
+// ErrorCount returns the number of problems that fail validation.
+func (r ValidationReport) ErrorCount() int {
+	count := 0
+	for _, f := range r.Files {
+		count += f.Errors.ErrorCount()
+	}
+	return count
+}
+
+// WarningCount returns the number of warnings.
+func (r ValidationReport) WarningCount() int {
+	count := 0
+	for _, f := range r.Files {
+		count += len(f.Errors) - f.Errors.ErrorCount()
+	}
+	return count
+}
+
+// FailedFiles returns the number of files with problems that fail validation.
+func (r ValidationReport) FailedFiles() int {
+	count := 0
+	for _, f := range r.Files {
+		if f.Errors.ErrorCount() > 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// ValidateRadar validates all technologies files in the input directory.
+// When all files are valid, technologies of the latest snapshot that stayed
+// in a ring longer than the ring's maxAge and likely typos in names across
+// snapshots are reported as warnings, and the latest snapshot is checked
+// with the enabled lint rules (see ReadLintConfig). Unknown keys are reported unless lenient is set.
+func ValidateRadar(inputDir string, meta core.Meta, now time.Time, lenient bool) (ValidationReport, error) {
+	var report ValidationReport
+
+	lintConfig, err := ReadLintConfig(inputDir, meta, lenient)
+	if err != nil {
+		return report, err
+	}
+
+	validFiles, err := GetRadarFiles(inputDir, meta)
+	if err != nil {
+		return report, err
+	}
+
+	for _, file := range validFiles {
+		report.Files = append(report.Files, FileValidation{
+			File:   file,
+			Errors: CollectValidationErrors(file, meta, lenient),
+		})
+	}
+
+	if len(validFiles) == 0 || report.ErrorCount() > 0 {
+		return report, nil
+	}
+
+	// Drafts are validated like published snapshots, files match report.Files
+	files, err := ReadTechnologiesFiles(inputDir, meta, ReadOptions{Lenient: lenient, IncludeDrafts: true})
+	if err != nil {
+		return report, err
+	}
+	err = addHistoryWarnings(files, meta, now, lintConfig, func(i int) *FileValidation {
+		return &report.Files[i]
+	})
+	return report, err
+}
+
+// ValidateRadarFromGit validates a technologies file whose snapshots are read from its git
+// history (see ReadTechnologiesFromGit). The file in the working tree is validated like a
+// snapshot file. When it is valid, its committed versions, drafts included, are checked for
+// overdue technologies, likely typos and lint rules like in ValidateRadar, and the problems
+// of the last committed version are reported for the file. Invalid older versions are
+// reported as warnings.
+func ValidateRadarFromGit(filePath string, meta core.Meta, tags bool, now time.Time, lenient bool) (ValidationReport, error) {
+	var report ValidationReport
+
+	lintConfig, err := ReadLintConfig(filepath.Dir(filePath), meta, lenient)
+	if err != nil {
+		return report, err
+	}
+
+	report.Files = append(report.Files, FileValidation{
+		File:   filePath,
+		Errors: CollectValidationErrors(filePath, meta, lenient),
+	})
+	if report.ErrorCount() > 0 {
+		return report, nil
+	}
+
+	files, skipped, err := ReadTechnologiesFromGit(filePath, meta, tags, ReadOptions{Lenient: lenient, IncludeDrafts: true})
+	if err != nil {
+		return report, err
+	}
+	report.Files[0].Errors = append(report.Files[0].Errors, skipped...)
+	err = addHistoryWarnings(files, meta, now, lintConfig, func(i int) *FileValidation {
+		if i != len(files)-1 {
+			return nil // older versions can't be fixed
 		}
-		if tech.Description == "" {
-			return fmt.Errorf("technology '%s' is missing 'description' field", tech.Name)
+		return &report.Files[0]
+	})
+	return report, err
+}
+
+// addHistoryWarnings adds overdue technologies and likely typos as warnings, and the problems
+// found by the lint rules in the latest snapshot. fileOf returns the validation of the i-th
+// snapshot of files the problems are added to, or nil if they are not reported.
+func addHistoryWarnings(files []core.TechnologiesFile, meta core.Meta, now time.Time, lintConfig core.LintConfig, fileOf func(i int) *FileValidation) error {
+	overdue, err := FindOverdueTechnologies(files, meta, now)
+	if err != nil {
+		return err
+	}
+
+	latest := fileOf(len(files) - 1)
+	for _, o := range overdue {
+		latest.Errors = append(latest.Errors, core.ValidationError{
+			File:       cmp.Or(o.Position.File, latest.File),
+			Technology: o.Name,
+			Field:      "ring",
+			Rule:       core.RuleMaxAge,
+			Severity:   core.SeverityWarning,
+			Message:    o.String(),
+			Line:       o.Position.Line,
+			Column:     o.Position.Column,
+		})
+	}
+
+	for _, typo := range FindPossibleTypos(files) {
+		f := fileOf(typo.Index)
+		if f == nil {
+			continue
 		}
+		f.Errors = append(f.Errors, core.ValidationError{
+			File:       cmp.Or(typo.Position.File, f.File),
+			Technology: typo.Name,
+			Field:      "name",
+			Rule:       core.RulePossibleTypo,
+			Severity:   core.SeverityWarning,
+			Message:    typo.String(),
+			Line:       typo.Position.Line,
+			Column:     typo.Position.Column,
+		})
 	}
 
+	lintErrors, err := LintSnapshot(files, latest.File, meta, lintConfig)
+	if err != nil {
+		return err
+	}
+	latest.Errors = append(latest.Errors, lintErrors...)
 	return nil
 }
+
+// yamlLinePattern matches the line number in YAML parser errors, e.g. "yaml: line 3: ..."
+var yamlLinePattern = regexp.MustCompile(`\bline (\d+)\b`)
+
+// yamlErrorLine extracts the line number from a YAML parser error, or 0 if there is none.
+func yamlErrorLine(err error) int {
+	m := yamlLinePattern.FindStringSubmatch(err.Error())
+	if m == nil {
+		return 0
+	}
+	line, _ := strconv.Atoi(m[1])
+	return line
+}
@@ -196,3 +196,130 @@ func TestValidateTechnologiesFile(t *testing.T) {
 		}
 	})
 }
+
+func TestCollectValidationErrors(t *testing.T) {
+	meta := core.DefaultMeta()
+
+	t.Run("all problems are reported", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		filePath := filepath.Join(tmpDir, "test.yaml")
+
+		content := `technologies:
+  - name: "Go"
+    ring: "InvalidRing"
+    quadrant: "Languages"
+    description: "Programming language"
+  - name: "Rust"
+    ring: "Adopt"
+    quadrant: "InvalidQuadrant"
+  - ring: ""
+    quadrant: "Languages"
+    description: "No name"
+`
+		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create test file: %v", err)
+		}
+
+		errs := CollectValidationErrors(filePath, meta, false)
+
+		expected := []core.ValidationError{
+			{File: filePath, Technology: "Go", Field: "ring", Rule: core.RuleInvalidRing, Severity: core.SeverityError, Message: "invalid ring 'InvalidRing' in technology 'Go'", Line: 3, Column: 11},
+			{File: filePath, Technology: "Rust", Field: "description", Rule: core.RuleMissingField, Severity: core.SeverityError, Message: "technology 'Rust' is missing 'description' field", Line: 6, Column: 5},
+			{File: filePath, Technology: "Rust", Field: "quadrant", Rule: core.RuleInvalidQuadrant, Severity: core.SeverityError, Message: "invalid quadrant 'InvalidQuadrant' in technology 'Rust'", Line: 8, Column: 15},
+			{File: filePath, Field: "name", Rule: core.RuleMissingField, Severity: core.SeverityError, Message: "technology #3 is missing 'name' field", Line: 9, Column: 5},
+			{File: filePath, Field: "ring", Rule: core.RuleMissingField, Severity: core.SeverityError, Message: "technology #3 is missing 'ring' field", Line: 9, Column: 11},
+		}
+		if len(errs) != len(expected) {
+			t.Fatalf("Expected %d errors, got %d: %v", len(expected), len(errs), errs)
+		}
+		for i, e := range expected {
+			if errs[i] != e {
+				t.Errorf("Error %d: expected %+v, got %+v", i, e, errs[i])
+			}
+		}
+
+		if err := ValidateTechnologiesFile(filePath, meta); err == nil || err.Error() != expected[0].Message {
+			t.Errorf("Expected first error %q, got %v", expected[0].Message, err)
+		}
+	})
+
+	t.Run("syntax error has line number", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		filePath := filepath.Join(tmpDir, "test.yaml")
+
+		content := `technologies:
+  - name: "Go"
+    ring: "Adopt
+`
+		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create test file: %v", err)
+		}
+
+		errs := CollectValidationErrors(filePath, meta, false)
+		if len(errs) != 1 || errs[0].Line == 0 {
+			t.Errorf("Expected one error with a line number, got %+v", errs)
+		}
+	})
+
+	t.Run("valid file", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		filePath := filepath.Join(tmpDir, "test.yaml")
+
+		content := `technologies:
+  - name: "Go"
+    ring: "Adopt"
+    quadrant: "Languages"
+    description: "Programming language"
+`
+		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create test file: %v", err)
+		}
+
+		if errs := CollectValidationErrors(filePath, meta, false); len(errs) != 0 {
+			t.Errorf("Expected no errors, got %v", errs)
+		}
+	})
+	t.Run("snapshot fields", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		filePath := filepath.Join(tmpDir, "test.yaml")
+
+		content := `title: "Platform consolidation"
+summary: "We moved to **one** platform."
+publishedAt: "15.01.2024"
+technologies:
+  - name: "Go"
+    ring: "Adopt"
+    quadrant: "Languages"
+    description: "Programming language"
+`
+		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create test file: %v", err)
+		}
+
+		errs := CollectValidationErrors(filePath, meta, false)
+		if len(errs) != 1 || errs[0].Rule != core.RuleInvalidDate || errs[0].Field != "publishedAt" {
+			t.Errorf("Expected one invalid date error, got %+v", errs)
+		}
+	})
+	t.Run("invalid reviewedAt", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		filePath := filepath.Join(tmpDir, "test.yaml")
+
+		// Rings without maxAge are checked too
+		content := `technologies:
+  - name: "Go"
+    ring: "Adopt"
+    quadrant: "Languages"
+    description: "Programming language"
+    reviewedAt: "June"
+`
+		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create test file: %v", err)
+		}
+
+		errs := CollectValidationErrors(filePath, meta, false)
+		if len(errs) != 1 || errs[0].Rule != core.RuleInvalidDate || errs[0].Field != "reviewedAt" || errs[0].Line != 2 {
+			t.Errorf("Expected one invalid date error, got %+v", errs)
+		}
+	})
+}
@@ -0,0 +1,222 @@
+package usecases
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+// WriteValidationJSON writes the validation report as JSON.
+func WriteValidationJSON(w io.Writer, report ValidationReport) error {
+	out := struct {
+		ValidationReport
+		ErrorCount   int `json:"errorCount"`
+		WarningCount int `json:"warningCount"`
+	}{report, report.ErrorCount(), report.WarningCount()}
+	for i, f := range out.Files {
+		if f.Errors == nil {
+			out.Files[i].Errors = core.ValidationErrors{}
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+// junitTestSuites is the root element of a JUnit XML report.
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteValidationJUnit writes the validation report as JUnit XML, one test case per file.
+// Errors fail the test case, warnings are reported in its output.
+func WriteValidationJUnit(w io.Writer, report ValidationReport) error {
+	suite := junitTestSuite{Name: "terago validate", Tests: len(report.Files)}
+
+	for _, f := range report.Files {
+		tc := junitTestCase{Name: filepath.Base(f.File), ClassName: "terago.validate"}
+
+		var errorLines, warningLines []string
+		for _, e := range f.Errors {
+			if e.IsWarning() {
+				warningLines = append(warningLines, e.String())
+			} else {
+				errorLines = append(errorLines, e.String())
+			}
+		}
+		if len(errorLines) > 0 {
+			suite.Failures++
+			tc.Failure = &junitFailure{
+				Message: fmt.Sprintf("%d validation error(s)", len(errorLines)),
+				Type:    core.SeverityError,
+				Text:    strings.Join(errorLines, "\n"),
+			}
+		}
+		tc.SystemOut = strings.Join(warningLines, "\n")
+
+		suite.Cases = append(suite.Cases, tc)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(junitTestSuites{Suites: []junitTestSuite{suite}}); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// sarifRules describes validation rules for SARIF consumers.
+var sarifRules = []struct {
+	ID          string
+	Description string
+}{
+	{core.RuleSyntax, "File is not valid YAML"},
+	{core.RuleEmpty, "File has no technologies"},
+	{core.RuleMissingField, "Technology is missing a required field"},
+	{core.RuleUnknownField, "Unknown field, likely a typo in the key"},
+	{core.RuleInvalidDate, "Snapshot publishedAt or technology reviewedAt is not a YYYY-MM-DD date"},
+	{core.RuleInvalidRing, "Technology ring is not defined in meta"},
+	{core.RuleInvalidQuadrant, "Technology quadrant is not defined in meta"},
+	{core.RuleMaxAge, "Technology stayed in a ring longer than the ring's maxAge"},
+	{core.RuleDuplicate, "Technology is listed more than once in a snapshot"},
+	{core.RulePossibleTypo, "Technology name is likely a typo of a technology deleted in the same snapshot"},
+	{core.RuleInvalidRevision, "Older Git revision of the file is invalid and was skipped"},
+}
+
+// sarifLog is the root object of a SARIF 2.1.0 report.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+// WriteValidationSARIF writes the validation report in SARIF 2.1.0 format
+// for code scanning UIs.
+func WriteValidationSARIF(w io.Writer, report ValidationReport) error {
+	rules := []sarifRule{}
+	for _, r := range sarifRules {
+		rules = append(rules, sarifRule{ID: r.ID, ShortDescription: sarifMessage{Text: r.Description}})
+	}
+	for _, r := range lintRules {
+		rules = append(rules, sarifRule{ID: r.Name(), ShortDescription: sarifMessage{Text: r.Description()}})
+	}
+
+	results := []sarifResult{}
+	for _, f := range report.Files {
+		for _, e := range f.Errors {
+			physical := sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: filepath.ToSlash(e.File)},
+			}
+			if e.Line > 0 {
+				physical.Region = &sarifRegion{StartLine: e.Line, StartColumn: e.Column}
+			}
+			results = append(results, sarifResult{
+				RuleID:    e.Rule,
+				Level:     e.Severity,
+				Message:   sarifMessage{Text: e.Message},
+				Locations: []sarifLocation{{PhysicalLocation: physical}},
+			})
+		}
+	}
+
+	sarif := sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{{
+			Tool: sarifTool{
+				Driver: sarifDriver{
+					Name:           "terago",
+					Version:        core.Version,
+					InformationURI: "https://github.com/ekalinin/terago",
+					Rules:          rules,
+				},
+			},
+			Results: results,
+		}},
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(sarif)
+}
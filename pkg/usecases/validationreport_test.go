@@ -0,0 +1,140 @@
+package usecases
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/ekalinin/terago/pkg/core"
+)
+
+// testValidationReport returns a report with one valid file, one invalid file and a warning
+func testValidationReport() ValidationReport {
+	return ValidationReport{Files: []FileValidation{
+		{File: "data/20240101.yaml"},
+		{File: "data/20240201.yaml", Errors: core.ValidationErrors{
+			{File: "data/20240201.yaml", Technology: "Go", Field: "ring", Rule: core.RuleInvalidRing,
+				Severity: core.SeverityError, Message: "invalid ring 'Adpot' in technology 'Go'", Line: 3, Column: 11},
+			{File: "data/20240201.yaml", Technology: "Kafka", Field: "ring", Rule: core.RuleMaxAge,
+				Severity: core.SeverityWarning, Message: "technology 'Kafka' is overdue", Line: 7, Column: 11},
+		}},
+	}}
+}
+
+func TestValidationReportCounts(t *testing.T) {
+	report := testValidationReport()
+	if got := report.ErrorCount(); got != 1 {
+		t.Errorf("Expected 1 error, got %d", got)
+	}
+	if got := report.WarningCount(); got != 1 {
+		t.Errorf("Expected 1 warning, got %d", got)
+	}
+	if got := report.FailedFiles(); got != 1 {
+		t.Errorf("Expected 1 failed file, got %d", got)
+	}
+}
+
+func TestWriteValidationJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteValidationJSON(&buf, testValidationReport()); err != nil {
+		t.Fatalf("WriteValidationJSON failed: %v", err)
+	}
+
+	var out struct {
+		Files []struct {
+			File   string                 `json:"file"`
+			Errors []core.ValidationError `json:"errors"`
+		} `json:"files"`
+		ErrorCount   int `json:"errorCount"`
+		WarningCount int `json:"warningCount"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatalf("Invalid JSON: %v\n%s", err, buf.String())
+	}
+	if len(out.Files) != 2 || out.Files[0].Errors == nil || len(out.Files[1].Errors) != 2 {
+		t.Errorf("Unexpected files: %+v", out.Files)
+	}
+	if out.Files[1].Errors[0].Line != 3 || out.Files[1].Errors[1].Severity != core.SeverityWarning {
+		t.Errorf("Unexpected errors: %+v", out.Files[1].Errors)
+	}
+	if out.ErrorCount != 1 || out.WarningCount != 1 {
+		t.Errorf("Expected 1 error and 1 warning, got %d and %d", out.ErrorCount, out.WarningCount)
+	}
+}
+
+func TestWriteValidationJUnit(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteValidationJUnit(&buf, testValidationReport()); err != nil {
+		t.Fatalf("WriteValidationJUnit failed: %v", err)
+	}
+
+	var suites junitTestSuites
+	if err := xml.Unmarshal(buf.Bytes(), &suites); err != nil {
+		t.Fatalf("Invalid XML: %v\n%s", err, buf.String())
+	}
+	if len(suites.Suites) != 1 {
+		t.Fatalf("Expected 1 test suite, got %d", len(suites.Suites))
+	}
+	suite := suites.Suites[0]
+	if suite.Tests != 2 || suite.Failures != 1 || len(suite.Cases) != 2 {
+		t.Errorf("Unexpected suite: %+v", suite)
+	}
+	if suite.Cases[0].Name != "20240101.yaml" || suite.Cases[0].Failure != nil {
+		t.Errorf("Expected first file to pass, got %+v", suite.Cases[0])
+	}
+	failure := suite.Cases[1].Failure
+	if failure == nil || !strings.Contains(failure.Text, "data/20240201.yaml:3:11: invalid ring 'Adpot'") {
+		t.Errorf("Expected failure for invalid ring, got %+v", failure)
+	}
+	if strings.Contains(failure.Text, "Kafka") || !strings.Contains(suite.Cases[1].SystemOut, "warning: technology 'Kafka'") {
+		t.Errorf("Expected warning in system-out only, got %+v", suite.Cases[1])
+	}
+}
+
+func TestWriteValidationSARIF(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteValidationSARIF(&buf, testValidationReport()); err != nil {
+		t.Fatalf("WriteValidationSARIF failed: %v", err)
+	}
+
+	var out struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Results []struct {
+				RuleID    string `json:"ruleId"`
+				Level     string `json:"level"`
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct {
+							URI string `json:"uri"`
+						} `json:"artifactLocation"`
+						Region struct {
+							StartLine   int `json:"startLine"`
+							StartColumn int `json:"startColumn"`
+						} `json:"region"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatalf("Invalid JSON: %v\n%s", err, buf.String())
+	}
+	if out.Version != "2.1.0" || len(out.Runs) != 1 || len(out.Runs[0].Results) != 2 {
+		t.Fatalf("Unexpected SARIF: %s", buf.String())
+	}
+
+	result := out.Runs[0].Results[0]
+	location := result.Locations[0].PhysicalLocation
+	if result.RuleID != core.RuleInvalidRing || result.Level != "error" {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+	if location.ArtifactLocation.URI != "data/20240201.yaml" || location.Region.StartLine != 3 || location.Region.StartColumn != 11 {
+		t.Errorf("Unexpected location: %+v", location)
+	}
+	if out.Runs[0].Results[1].Level != "warning" {
+		t.Errorf("Expected warning level, got %s", out.Runs[0].Results[1].Level)
+	}
+}
@@ -0,0 +1,166 @@
+package usecases
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// marshalYAML encodes v to YAML using 2-space indentation (the style of the radar files).
+// Comments are kept when v is a *yaml.Node.
+func marshalYAML(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// mappingValue returns the value node for key in a mapping node, or nil if not found.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// documentRoot returns the top-level node of a parsed YAML document.
+func documentRoot(doc *yaml.Node) *yaml.Node {
+	if doc.Kind == yaml.DocumentNode && len(doc.Content) > 0 {
+		return doc.Content[0]
+	}
+	return doc
+}
+
+// removeMappingKeys removes the keys and their values from a mapping node.
+func removeMappingKeys(node *yaml.Node, keys []string) {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return
+	}
+	var content []*yaml.Node
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if !slices.Contains(keys, node.Content[i].Value) {
+			content = append(content, node.Content[i], node.Content[i+1])
+		}
+	}
+	node.Content = content
+}
+
+// lineIndent returns the number of leading spaces of a line.
+func lineIndent(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// isBlockStyle reports whether a collection node is written in block style, one entry per line,
+// so its entries can be edited line by line keeping the formatting of the rest of the file.
+func isBlockStyle(node *yaml.Node) bool {
+	return node != nil && node.Style&yaml.FlowStyle == 0
+}
+
+// splitLines splits content into lines, keeping the line endings.
+func splitLines(data []byte) []string {
+	return strings.SplitAfter(string(data), "\n")
+}
+
+// entryLines returns the 0-based range [start, end) of the lines of a block collection entry
+// (a mapping key with its value or a sequence item) starting at the 1-based line, where
+// the entry is indented by indent spaces: the line and the following lines indented deeper.
+// Trailing blank lines and comments not indented deeper are left out, as they belong
+// to the next entry.
+func entryLines(lines []string, line, indent int) (int, int) {
+	start := line - 1
+	end := start + 1
+	for end < len(lines) {
+		trimmed := strings.TrimSpace(lines[end])
+		if trimmed != "" && !strings.HasPrefix(trimmed, "#") && lineIndent(lines[end]) <= indent {
+			break
+		}
+		end++
+	}
+	for end > start+1 {
+		trimmed := strings.TrimSpace(lines[end-1])
+		if trimmed != "" && (!strings.HasPrefix(trimmed, "#") || lineIndent(lines[end-1]) > indent) {
+			break
+		}
+		end--
+	}
+	return start, end
+}
+
+// removeEntries removes block collection entries from the content, keeping the rest of
+// the content as is. Entries are mapping keys or sequence items; the comment lines directly
+// above a sequence item that has a head comment and the blank lines separating an entry
+// from its neighbours are removed with it.
+func removeEntries(data []byte, entries []*yaml.Node) []byte {
+	lines := splitLines(data)
+	remove := make([]bool, len(lines))
+	for _, entry := range entries {
+		indent := lineIndent(lines[entry.Line-1])
+		start, end := entryLines(lines, entry.Line, indent)
+		if entry.Kind != yaml.ScalarNode && entry.HeadComment != "" {
+			for start > 0 && strings.HasPrefix(strings.TrimSpace(lines[start-1]), "#") && lineIndent(lines[start-1]) == indent {
+				start--
+			}
+		}
+		// Blank lines separating the entry from the previous or the next one
+		isBlank := func(i int) bool { return strings.TrimSpace(lines[i]) == "" && i < len(lines)-1 }
+		if start > 0 && isBlank(start-1) {
+			for start > 0 && isBlank(start-1) {
+				start--
+			}
+		} else {
+			for end < len(lines) && isBlank(end) {
+				end++
+			}
+		}
+		for i := start; i < end; i++ {
+			remove[i] = true
+		}
+	}
+	var buf strings.Builder
+	for i, line := range lines {
+		if !remove[i] {
+			buf.WriteString(line)
+		}
+	}
+	return []byte(buf.String())
+}
+
+// replaceLines replaces the lines [start, end) with text, inserting it if start equals end.
+// The first line of text is prefixed with first and the following non-empty lines with rest.
+func replaceLines(lines []string, start, end int, text, first, rest string) []byte {
+	var buf strings.Builder
+	for _, line := range lines[:start] {
+		buf.WriteString(line)
+	}
+	if start > 0 && !strings.HasSuffix(lines[start-1], "\n") {
+		buf.WriteString("\n")
+	}
+	for i, line := range splitLines([]byte(text)) {
+		if line == "" {
+			continue
+		}
+		if i == 0 {
+			buf.WriteString(first)
+		} else if line != "\n" {
+			buf.WriteString(rest)
+		}
+		buf.WriteString(line)
+	}
+	for _, line := range lines[end:] {
+		buf.WriteString(line)
+	}
+	return []byte(buf.String())
+}